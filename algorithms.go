@@ -1,6 +1,7 @@
 package money
 
 import (
+	"context"
 	"math"
 )
 
@@ -8,101 +9,554 @@ var (
 	maxVal = wrap(zero().SetUint64(math.MaxUint64))
 	minVal = wrap(zero().Neg(maxVal.value))
 	ten    = wrap(zero().SetMantScale(10, 0))
+	two    = wrap(zero().SetMantScale(2, 0))
 	half   = wrap(zero().SetMantScale(5, 1))
 )
 
 // GoalSeek attempts to find a value x (to the specified precision), where min <= x <= max,
 // such that f(x) = target.
+//
+// The result is rounded to the precision in significant figures, without modifying min, max
+// or any value passed to f. GoalSeek uses bisection; see GoalSeekWith for faster methods.
 func GoalSeek(min, max, target Decimal, precision int, f func(Decimal) Decimal) (Decimal, bool) {
-	// Translate target to zero i.e. g(x) = f(x) - target = 0
-	g := func(x Decimal) Decimal {
-		return f(x).Sub(target)
+	return GoalSeekWith(SolveOptions{Precision: precision}, min, max, target, f)
+}
+
+// SolveMethod is a numerical method for finding a value x such that f(x) = target.
+type SolveMethod int
+
+// Solve methods.
+const (
+	// Bisection repeatedly halves a range in which f(x) - target changes sign. It is robust
+	// but needs an evaluation of f for every bit of precision.
+	Bisection SolveMethod = iota
+	// Brent combines bisection with secant and inverse quadratic interpolation, converging
+	// much faster than bisection for smooth functions while remaining as robust.
+	Brent
+	// Illinois is the regula falsi (false position) method with the Illinois modification,
+	// interpolating linearly within a range in which f(x) - target changes sign.
+	Illinois
+	// Secant interpolates through the last two points, starting from min and max.
+	// It does not need a change of sign, but may fail to converge.
+	Secant
+	// Newton follows the tangent to f, starting from the midpoint of min and max.
+	// It does not need a change of sign, but may fail to converge.
+	Newton
+)
+
+// SolveOptions determine how Solve and GoalSeekWith find a solution.
+type SolveOptions struct {
+	Method    SolveMethod
+	Precision int // significant figures of the solution, or 12 if zero
+
+	// Derivative is the derivative of f used by Newton. If nil, it is approximated with
+	// a central difference, which takes two evaluations of f.
+	Derivative func(Decimal) Decimal
+
+	MaxIterations  int // iterations of the method, or 1000 if zero
+	MaxEvaluations int // evaluations of f, or unlimited if zero
+}
+
+// Termination is the reason Solve stopped.
+type Termination int
+
+// Termination reasons.
+const (
+	// Converged means a solution was found to the precision.
+	Converged Termination = iota
+	// NoChangeOfSign means no range was found in [min, max] in which f(x) - target changes sign.
+	NoChangeOfSign
+	// OutOfRange means the next estimate of Secant or Newton was outside [min, max].
+	OutOfRange
+	// Stalled means Secant or Newton could not continue because the slope of f was zero or infinite.
+	Stalled
+	// NotANumber means f or its derivative returned NaN.
+	NotANumber
+	// IterationLimit means MaxIterations was reached.
+	IterationLimit
+	// EvaluationLimit means MaxEvaluations was reached.
+	EvaluationLimit
+	// Cancelled means the context was cancelled or its deadline passed.
+	Cancelled
+)
+
+// SolveResult describes the solution found by Solve, or why none was found.
+type SolveResult struct {
+	// Value is the solution rounded to the precision or, if Reason is not Converged,
+	// the best estimate so far. It is zero if there is no estimate.
+	Value  Decimal
+	Reason Termination
+
+	// Iterations counts iterations of the method, including each pass of the search
+	// for a change of sign.
+	Iterations  int
+	Evaluations int // evaluations of f
+
+	// Left and Right are the final range in which f(x) - target changes sign,
+	// or the last two estimates for Secant and Newton.
+	Left, Right Decimal
+
+	// Residual is f(x) - target at the last estimate evaluated, which may differ slightly from Value.
+	Residual Decimal
+}
+
+// GoalSeekWith is like GoalSeek but uses the given options.
+//
+// Bisection, Brent and Illinois first search for a range in which f(x) - target changes sign,
+// as GoalSeek does. Secant and Newton fail if an estimate falls outside [min, max].
+func GoalSeekWith(opts SolveOptions, min, max, target Decimal, f func(Decimal) Decimal) (Decimal, bool) {
+	result, err := Solve(context.Background(), opts, min, max, target, f)
+	return result.Value, err == nil
+}
+
+// Solve is like GoalSeekWith but describes how the solution was found, and stops early if
+// ctx is done, in which case it returns ctx.Err(). If no solution is found for any other
+// reason, it returns ErrNoConvergence.
+func Solve(ctx context.Context, opts SolveOptions, min, max, target Decimal, f func(Decimal) Decimal) (SolveResult, error) {
+	if opts.Precision <= 0 {
+		opts.Precision = 12
+	}
+	if opts.MaxIterations <= 0 {
+		opts.MaxIterations = 1000
+	}
+	s := solver{ctx: ctx, opts: opts, target: target, f: f, left: min, right: max}
+	s.solve(min, max)
+
+	switch s.result.Reason {
+	case Converged:
+		return s.result, nil
+	case Cancelled:
+		return s.result, ctx.Err()
+	default:
+		return s.result, ErrNoConvergence
+	}
+}
+
+// solver holds the state of a search for x such that f(x) = target.
+type solver struct {
+	ctx    context.Context
+	opts   SolveOptions
+	target Decimal
+	f      func(Decimal) Decimal
+
+	x, fx       Decimal     // the best estimate so far and f(x) - target
+	left, right Decimal     // the range containing the solution, or the last two estimates
+	stopped     Termination // the reason eval or iterate failed
+	result      SolveResult
+}
+
+func (s *solver) solve(min, max Decimal) {
+	fmin, ok := s.eval(min)
+	if !ok {
+		s.stop()
+		return
 	}
 
 	// min is a solution
-	if g(min).value.Sign() == 0 {
-		return min, true
+	if fmin.IsZero() {
+		s.finish(Converged, min, fmin)
+		return
+	}
+
+	fmax, ok := s.eval(max)
+	if !ok {
+		s.stop()
+		return
 	}
 
 	// max is a solution
-	if g(max).value.Sign() == 0 {
-		return max, true
+	if fmax.IsZero() {
+		s.finish(Converged, max, fmax)
+		return
 	}
 
-	// Find zero-crossing bracket
-	left, right, ok := bracket(min, max, precision, g)
+	switch s.opts.Method {
+	case Secant:
+		s.secant(min, max, fmin, fmax)
+		return
+	case Newton:
+		s.newton(min, max)
+		return
+	}
 
-	// No solutions
+	// Find zero-crossing bracket
+	fleft, fright, ok := s.bracket(min, max, fmin, fmax)
 	if !ok {
-		return wrap(zero()), false
+		return
 	}
 
 	// left bracket is a solution
-	if g(left).value.Sign() == 0 {
-		return left, true
+	if fleft.IsZero() {
+		s.finish(Converged, s.left, fleft)
+		return
 	}
 
 	// right bracket is a solution
-	if g(right).value.Sign() == 0 {
-		return right, true
+	if fright.IsZero() {
+		s.finish(Converged, s.right, fright)
+		return
+	}
+
+	switch s.opts.Method {
+	case Brent:
+		s.brent(fleft, fright)
+	case Illinois:
+		s.illinois(fleft, fright)
+	default:
+		s.bisect(fleft)
+	}
+}
+
+// eval calculates f(x) - target, returning false if the search must stop.
+func (s *solver) eval(x Decimal) (Decimal, bool) {
+	if s.ctx.Err() != nil {
+		s.stopped = Cancelled
+		return Decimal{}, false
+	}
+	if s.opts.MaxEvaluations > 0 && s.result.Evaluations >= s.opts.MaxEvaluations {
+		s.stopped = EvaluationLimit
+		return Decimal{}, false
+	}
+	s.result.Evaluations++
+
+	y := s.f(x)
+	if y.val().IsNaN(0) {
+		s.stopped = NotANumber
+		return y, false
+	}
+	return y.Sub(s.target), true
+}
+
+// iterate counts an iteration, returning false if the limit has been reached.
+func (s *solver) iterate() bool {
+	if s.result.Iterations >= s.opts.MaxIterations {
+		s.stopped = IterationLimit
+		return false
 	}
+	s.result.Iterations++
+	return true
+}
+
+// estimate records the best estimate so far.
+func (s *solver) estimate(x, fx Decimal) {
+	s.x, s.fx = x, fx
+}
 
-	// bisect the bracket to find the solution
-	result, found := bisect(left, right, precision, g)
+// finish records the result.
+func (s *solver) finish(reason Termination, x, fx Decimal) {
+	s.result.Reason = reason
+	s.result.Value, s.result.Residual = x, fx
+	s.result.Left, s.result.Right = s.left, s.right
+}
+
+// stop records the best estimate so far, after eval or iterate failed.
+func (s *solver) stop() {
+	s.finish(s.stopped, s.round(s.x), s.fx)
+}
 
-	return result, found
+func (s *solver) round(x Decimal) Decimal {
+	if x.value == nil {
+		return x
+	}
+	return defaultContext.Round(x, s.opts.Precision)
 }
 
-func bracket(min, max Decimal, precision int, g func(Decimal) Decimal) (Decimal, Decimal, bool) {
-	// Sweep left to right in smaller and smaller increments until a bracket is found
-	// or the values from g are identical to a given precision, then give up.
+// bracket sweeps [min, max] in smaller and smaller increments until a range is found in which
+// f(x) - target changes sign, or the values are identical to the precision, then gives up.
+func (s *solver) bracket(min, max, fmin, fmax Decimal) (Decimal, Decimal, bool) {
 	inc := max.Sub(min)
 
-	for {
-		for left := min; left.LessThan(max); left = left.Add(inc) {
-			right := left.Add(inc)
-			gleft, gright := g(left), g(right)
-			leftSign := gleft.value.Signbit()
-			rightSign := gright.value.Signbit()
+	for s.iterate() {
+		left, fleft := min, fmin
+		for left.LessThan(max) {
+			right, fright := left.Add(inc), fmax
+			if right.LessThan(max) {
+				var ok bool
+				if fright, ok = s.eval(right); !ok {
+					s.stop()
+					return fleft, fright, false
+				}
+			}
 
 			// zero-crossing
-			if leftSign != rightSign {
-				return left, right, true
+			if fleft.value.Signbit() != fright.value.Signbit() {
+				s.left, s.right = left, right
+				return fleft, fright, true
 			}
 
 			// still no crossings but values are now the same, give up
-			if left.EqualTo(right, precision) && gleft.EqualTo(gright, precision) {
-				return min, max, false
+			if left.EqualTo(right, s.opts.Precision) && fleft.EqualTo(fright, s.opts.Precision) {
+				s.finish(NoChangeOfSign, wrap(zero()), Decimal{})
+				return fleft, fright, false
 			}
+			left, fleft = right, fright
 		}
 		inc = inc.Mul(half)
 	}
+
+	s.stop()
+	return Decimal{}, Decimal{}, false
+}
+
+// bisect finds a solution by repeatedly halving the bracket.
+func (s *solver) bisect(fleft Decimal) {
+	leftSign := fleft.value.Signbit()
+
+	for s.iterate() {
+		mid := s.left.Add(s.right).Mul(half)
+		fmid, ok := s.eval(mid)
+		if !ok {
+			break
+		}
+		s.estimate(mid, fmid)
+		if fmid.IsZero() {
+			s.finish(Converged, s.round(mid), fmid)
+			return
+		}
+
+		if fmid.value.Signbit() == leftSign {
+			s.left, fleft = mid, fmid
+		} else {
+			s.right = mid
+		}
+
+		if s.left.EqualTo(s.right, s.opts.Precision) {
+			s.finish(Converged, s.round(s.left), fleft)
+			return
+		}
+	}
+
+	s.stop()
+}
+
+// brent finds a solution within the bracket by Brent's method, as described in Numerical Recipes.
+//
+// b is the best estimate, a the previous estimate and the solution lies between b and c.
+func (s *solver) brent(fa, fb Decimal) {
+	a, b := s.left, s.right
+	c, fc := a, fa
+	d := b.Sub(a)
+	e := d
+	s.estimate(b, fb)
+
+	for s.iterate() {
+		if fb.Sign()*fc.Sign() > 0 {
+			c, fc = a, fa
+			d = b.Sub(a)
+			e = d
+		}
+		if fc.Abs().LessThan(fb.Abs()) {
+			a, b, c = b, c, b
+			fa, fb, fc = fb, fc, fb
+		}
+		s.left, s.right = Min(b, c), Max(b, c)
+		s.estimate(b, fb)
+
+		tol := tolerance(b, s.opts.Precision+1)
+		m := c.Sub(b).Mul(half)
+		if fb.IsZero() || m.Abs().LessThanOrEqual(tol) {
+			s.finish(Converged, s.round(b), fb)
+			return
+		}
+
+		if e.Abs().GreaterThanOrEqual(tol) && fa.Abs().GreaterThan(fb.Abs()) {
+			// Interpolate, with p/q the step from b.
+			var p, q Decimal
+			ratio := fb.Div(fa)
+			if a.Equals(c) {
+				// Secant
+				p = m.Mul(two).Mul(ratio)
+				q = NewInt(1).Sub(ratio)
+			} else {
+				// Inverse quadratic
+				q = fa.Div(fc)
+				r := fb.Div(fc)
+				p = ratio.Mul(m.Mul(two).Mul(q).Mul(q.Sub(r)).Sub(b.Sub(a).Mul(r.SubInt(1))))
+				q = q.SubInt(1).Mul(r.SubInt(1)).Mul(ratio.SubInt(1))
+			}
+			if p.IsPositive() {
+				q = q.Neg()
+			}
+			p = p.Abs()
+
+			// Accept the interpolation if it falls within the bracket and is converging quickly enough.
+			limit := Min(m.Mul(NewInt(3)).Mul(q).Sub(tol.Mul(q).Abs()), e.Mul(q).Abs())
+			if p.Mul(two).LessThan(limit) {
+				e, d = d, p.Div(q)
+			} else {
+				d, e = m, m
+			}
+		} else {
+			d, e = m, m
+		}
+
+		a, fa = b, fb
+		switch {
+		case d.Abs().GreaterThan(tol):
+			b = b.Add(d)
+		case m.IsPositive():
+			b = b.Add(tol)
+		default:
+			b = b.Sub(tol)
+		}
+		var ok bool
+		if fb, ok = s.eval(b); !ok {
+			break
+		}
+	}
+
+	s.stop()
 }
 
-func bisect(left, right Decimal, prec int, g func(Decimal) Decimal) (Decimal, bool) {
-	var mid, test Decimal
-	leftSign := g(left).value.Signbit()
+// illinois finds a solution within the bracket by the Illinois variant of regula falsi, which halves
+// the value at an end of the bracket that is retained twice in a row, so that both ends converge.
+func (s *solver) illinois(fa, fb Decimal) {
+	a, b := s.left, s.right
+	side := 0
 
-	for i := 0; i < 1000; i++ {
-		mid = left.Add(right).Mul(half)
-		test = g(mid)
-		if test.value.Sign() == 0 {
-			mid.value.Round(prec)
-			return mid, true
+	for i := 0; s.iterate(); i++ {
+		c := a.Mul(fb).Sub(b.Mul(fa)).Div(fb.Sub(fa))
+		fc, ok := s.eval(c)
+		if !ok {
+			break
+		}
+		prev := s.x
+		s.estimate(c, fc)
+		if fc.IsZero() || (i > 0 && converged(c, prev, s.opts.Precision)) {
+			s.finish(Converged, s.round(c), fc)
+			return
 		}
 
-		if test.value.Signbit() == leftSign {
-			left = mid
+		if fc.Sign() == fb.Sign() {
+			b, fb = c, fc
+			if side == -1 {
+				fa = fa.Mul(half)
+			}
+			side = -1
 		} else {
-			right = mid
+			a, fa = c, fc
+			if side == +1 {
+				fb = fb.Mul(half)
+			}
+			side = +1
+		}
+		s.left, s.right = a, b
+	}
+
+	s.stop()
+}
+
+// secant finds a solution by the secant method, starting from min and max.
+func (s *solver) secant(min, max, fmin, fmax Decimal) {
+	x0, x1 := min, max
+	f0, f1 := fmin, fmax
+	s.estimate(x1, f1)
+
+	for s.iterate() {
+		df := f1.Sub(f0)
+		if df.IsZero() {
+			s.stopped = Stalled
+			break
+		}
+		x2 := x1.Sub(f1.Mul(x1.Sub(x0)).Div(df))
+		if x2.LessThan(min) || x2.GreaterThan(max) {
+			s.stopped = OutOfRange
+			break
+		}
+		if converged(x2, x1, s.opts.Precision) {
+			s.finish(Converged, s.round(x2), f1)
+			return
+		}
+
+		f2, ok := s.eval(x2)
+		if !ok {
+			break
+		}
+		x0, f0, x1, f1 = x1, f1, x2, f2
+		s.left, s.right = x0, x1
+		s.estimate(x1, f1)
+		if f1.IsZero() {
+			s.finish(Converged, s.round(x1), f1)
+			return
+		}
+	}
+
+	s.stop()
+}
+
+// newton finds a solution by the Newton-Raphson method, starting from the midpoint of min and max.
+func (s *solver) newton(min, max Decimal) {
+	x := min.Add(max).Mul(half)
+	for s.iterate() {
+		fx, ok := s.eval(x)
+		if !ok {
+			break
+		}
+		s.estimate(x, fx)
+		if fx.IsZero() {
+			s.finish(Converged, s.round(x), fx)
+			return
 		}
 
-		if left.EqualTo(right, prec) {
-			left.value.Round(prec)
-			return left, true
+		slope, ok := s.slope(x)
+		if !ok {
+			break
+		}
+		next := x.Sub(fx.Div(slope))
+		if next.LessThan(min) || next.GreaterThan(max) {
+			s.stopped = OutOfRange
+			break
 		}
+		s.left, s.right = x, next
+		if converged(next, x, s.opts.Precision) {
+			s.finish(Converged, s.round(next), fx)
+			return
+		}
+		x = next
 	}
 
-	mid.value.Round(prec)
-	return mid, false
+	s.stop()
+}
+
+// slope returns the derivative of f at x, returning false if the search must stop.
+func (s *solver) slope(x Decimal) (Decimal, bool) {
+	var slope Decimal
+	if s.opts.Derivative != nil {
+		slope = s.opts.Derivative(x)
+	} else {
+		h := tolerance(x, s.opts.Precision)
+		above, ok := s.eval(x.Add(h))
+		if !ok {
+			return Decimal{}, false
+		}
+		below, ok := s.eval(x.Sub(h))
+		if !ok {
+			return Decimal{}, false
+		}
+		slope = above.Sub(below).Div(h.Mul(two))
+	}
+
+	switch {
+	case slope.val().IsNaN(0):
+		s.stopped = NotANumber
+		return slope, false
+	case slope.IsZero() || slope.val().IsInf(0):
+		s.stopped = Stalled
+		return slope, false
+	}
+	return slope, true
+}
+
+// converged returns true if successive estimates are within a tenth of the precision of each other.
+func converged(x, prev Decimal, prec int) bool {
+	return x.Sub(prev).Abs().LessThanOrEqual(tolerance(x, prec+1))
+}
+
+// tolerance returns half a unit in the last place of x rounded to prec significant figures.
+func tolerance(x Decimal, prec int) Decimal {
+	exp := 0
+	if v := x.val(); v.Sign() != 0 {
+		exp = v.Precision() - v.Scale() - 1
+	}
+	return NewScalar(5, prec-exp)
 }
@@ -1,8 +1,10 @@
 package money
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 )
 
 func ExampleGoalSeek() {
@@ -45,3 +47,236 @@ func TestGoalSeek(t *testing.T) {
 		})
 	}
 }
+
+func TestGoalSeekDoesNotModifyInputs(t *testing.T) {
+	// The solution is within the precision of min, so bisection converges without moving the left bound.
+	min, max := NewScalar(100001, 5), New(2)
+	got, ok := GoalSeek(min, max, NewScalar(1000011, 6), 3, func(x Decimal) Decimal { return x })
+	if fmt.Sprint(got) != "1.00" || !ok {
+		t.Errorf("got (%v,%t), want (1.00,true)", got, ok)
+	}
+	if fmt.Sprint(min) != "1.00001" || fmt.Sprint(max) != "2.00" {
+		t.Errorf("inputs modified: min %v, max %v", min, max)
+	}
+}
+
+func ExampleGoalSeekWith() {
+	// Find the monthly rate of a 30 year, 250,000 loan with payments of 1,500.
+	payment := func(rate Decimal) Decimal { return Payment(rate, 360, New(250000), Decimal{}, EndOfPeriod) }
+	opts := SolveOptions{Method: Brent, Precision: 6}
+	fmt.Println(GoalSeekWith(opts, NewInt(0), Pc(5), New(-1500), payment))
+	// Output: 0.00500583 true
+}
+
+func TestGoalSeekWith(t *testing.T) {
+	square := func(x Decimal) Decimal { return x.Mul(x) }
+	double := func(x Decimal) Decimal { return x.Mul(two) }
+	cubic := func(x Decimal) Decimal { return x.PowInt(3).Sub(x) }
+	payment := func(rate Decimal) Decimal { return Payment(rate, 360, New(250000), Decimal{}, EndOfPeriod) }
+
+	for _, tc := range []struct {
+		name     string
+		f, df    func(Decimal) Decimal
+		min, max Decimal
+		target   Decimal
+		prec     int
+		want     Decimal
+		ok       bool
+	}{
+		{"√2", square, double, NewInt(0), NewInt(3), NewInt(2), 6, NewScalar(141421, 5), true},
+		{"√2 precise", square, double, NewInt(1), NewInt(2), NewInt(2), 30, MustParse("1.41421356237309504880168872421"), true},
+		{"cubic", cubic, nil, NewInt(1), NewInt(2), NewInt(2), 8, NewScalar(15213797, 7), true},
+		{"exact", square, double, NewInt(0), NewInt(5), NewInt(9), 6, NewInt(3), true},
+		{"min", square, double, NewInt(2), NewInt(5), NewInt(4), 6, NewInt(2), true},
+		{"log", func(x Decimal) Decimal { return x.Log() }, nil, NewInt(1), NewInt(3), NewInt(1), 10, MustParse("2.718281828"), true},
+		{"loan rate", payment, nil, NewInt(0), Pc(5), Payment(Pm(5), 360, New(250000), Decimal{}, EndOfPeriod), 10, Pm(5), true},
+		{"no solution", square, double, NewInt(-1), NewInt(3), NewInt(-1), 3, NewInt(0), false},
+	} {
+		options := []SolveOptions{{Method: Bisection}, {Method: Brent}, {Method: Illinois}, {Method: Secant}, {Method: Newton}}
+		if tc.df != nil {
+			options = append(options, SolveOptions{Method: Newton, Derivative: tc.df})
+		}
+		for _, opts := range options {
+			opts.Precision = tc.prec
+			t.Run(fmt.Sprintf("%s/%d/derivative=%t", tc.name, opts.Method, opts.Derivative != nil), func(t *testing.T) {
+				got, ok := GoalSeekWith(opts, tc.min, tc.max, tc.target, tc.f)
+				if ok != tc.ok || (ok && !got.Equals(tc.want)) {
+					t.Errorf("got (%v,%t), want (%v,%t)", got, ok, tc.want, tc.ok)
+				}
+			})
+		}
+	}
+}
+
+func TestGoalSeekWithOpenMethodsFail(t *testing.T) {
+	square := func(x Decimal) Decimal { return x.Mul(x) }
+	for _, tc := range []struct {
+		name     string
+		method   SolveMethod
+		min, max Decimal
+	}{
+		{"newton zero slope", Newton, NewInt(-3), NewInt(3)},
+		{"newton out of range", Newton, NewInt(-1), NewScalar(12, 1)},
+		{"secant flat", Secant, NewInt(-3), NewInt(3)},
+		{"secant out of range", Secant, NewInt(-1), NewScalar(5, 1)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := GoalSeekWith(SolveOptions{Method: tc.method}, tc.min, tc.max, NewInt(2), square)
+			if ok {
+				t.Errorf("got (%v,%t), want failure", got, ok)
+			}
+		})
+	}
+}
+
+// BenchmarkGoalSeekWith compares the methods on finance functions, each to 12 significant figures
+// over a range in which the secant method converges.
+func BenchmarkGoalSeekWith(b *testing.B) {
+	loan := func(rate Decimal) Decimal { return Payment(rate, 360, New(250000), Decimal{}, EndOfPeriod) }
+	flows := []Decimal{New(-10000), New(2500), New(3000), New(3500), New(4000)}
+	irr := func(rate Decimal) Decimal { return discount(rate, flows) }
+	bond := Bond{Face: New(100), CouponRate: Pc(5), Frequency: 2, Issue: date(2020, time.January, 15), Maturity: date(2030, time.January, 15)}
+	settlement := date(2024, time.March, 1)
+	price := func(yield Decimal) Decimal { return bond.DirtyPrice(settlement, yield) }
+
+	for _, bc := range []struct {
+		name     string
+		f        func(Decimal) Decimal
+		min, max Decimal
+		target   Decimal
+	}{
+		{"Payment", loan, NewInt(0), Pc(5), New(-1500)},
+		{"IRR", irr, NewInt(0), Pc(50), NewInt(0)},
+		{"Bond", price, NewInt(0), Pc(20), New(95)},
+	} {
+		for _, method := range []struct {
+			name string
+			SolveMethod
+		}{{"Bisection", Bisection}, {"Brent", Brent}, {"Illinois", Illinois}, {"Secant", Secant}, {"Newton", Newton}} {
+			b.Run(bc.name+"/"+method.name, func(b *testing.B) {
+				opts := SolveOptions{Method: method.SolveMethod, Precision: 12}
+				for i := 0; i < b.N; i++ {
+					if _, ok := GoalSeekWith(opts, bc.min, bc.max, bc.target, bc.f); !ok {
+						b.Fatal("no solution")
+					}
+				}
+			})
+		}
+	}
+}
+
+func ExampleSolve() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	payment := func(rate Decimal) Decimal { return Payment(rate, 360, New(250000), Decimal{}, EndOfPeriod) }
+	opts := SolveOptions{Method: Bisection, Precision: 6, MaxEvaluations: 10}
+	result, err := Solve(ctx, opts, NewInt(0), Pc(5), New(-1500), payment)
+	fmt.Println(result.Reason == EvaluationLimit, err)
+	fmt.Println(result.Value, result.Left, result.Right)
+
+	opts.Method = Brent
+	result, err = Solve(ctx, opts, NewInt(0), Pc(5), New(-1500), payment)
+	fmt.Println(result.Reason == Converged, err)
+	fmt.Println(result.Value, result.Iterations, result.Evaluations)
+
+	// Output:
+	// true money: no solution found
+	// 0.00488281 0.0048828125 0.005078125
+	// true <nil>
+	// 0.00500583 8 8
+}
+
+func TestSolve(t *testing.T) {
+	square := func(x Decimal) Decimal { return x.Mul(x) }
+	for _, tc := range []struct {
+		name     string
+		opts     SolveOptions
+		f        func(Decimal) Decimal
+		min, max Decimal
+		target   Decimal
+		reason   Termination
+		err      error
+		value    string
+	}{
+		{"converged", SolveOptions{Method: Brent, Precision: 6}, square, NewInt(0), NewInt(3), NewInt(2), Converged, nil, "1.41421"},
+		{"min", SolveOptions{}, square, NewInt(2), NewInt(3), NewInt(4), Converged, nil, "2"},
+		{"no change of sign", SolveOptions{Precision: 3}, square, NewInt(-1), NewInt(3), NewInt(-1), NoChangeOfSign, ErrNoConvergence, "0"},
+		{"nan", SolveOptions{}, func(x Decimal) Decimal { return x.Log() }, NewInt(-2), NewInt(-1), NewInt(0), NotANumber, ErrNoConvergence, "0"},
+		{"nan derivative", SolveOptions{Method: Newton, Derivative: func(x Decimal) Decimal { return x.Log() }}, square, NewInt(-3), NewInt(-1), NewInt(2), NotANumber, ErrNoConvergence, "-2.0"},
+		{"iteration limit", SolveOptions{Precision: 6, MaxIterations: 5}, square, NewInt(0), NewInt(3), NewInt(2), IterationLimit, ErrNoConvergence, "1.3125"},
+		{"evaluation limit", SolveOptions{Method: Illinois, Precision: 6, MaxEvaluations: 4}, square, NewInt(0), NewInt(3), NewInt(2), EvaluationLimit, ErrNoConvergence, "1.09091"},
+		{"out of range", SolveOptions{Method: Newton}, square, NewInt(-1), NewScalar(12, 1), NewInt(2), OutOfRange, ErrNoConvergence, "0.10"},
+		{"stalled", SolveOptions{Method: Newton}, square, NewInt(-3), NewInt(3), NewInt(2), Stalled, ErrNoConvergence, "0"},
+		{"secant stalled", SolveOptions{Method: Secant}, square, NewInt(-3), NewInt(3), NewInt(2), Stalled, ErrNoConvergence, "3"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := Solve(context.Background(), tc.opts, tc.min, tc.max, tc.target, tc.f)
+			if result.Reason != tc.reason || err != tc.err || fmt.Sprint(result.Value) != tc.value {
+				t.Errorf("got (%v, %d, %v), want (%s, %d, %v)", result.Value, result.Reason, err, tc.value, tc.reason, tc.err)
+			}
+			if max := tc.opts.MaxEvaluations; max > 0 && result.Evaluations > max {
+				t.Errorf("%d evaluations, want at most %d", result.Evaluations, max)
+			}
+			if max := tc.opts.MaxIterations; max > 0 && result.Iterations > max {
+				t.Errorf("%d iterations, want at most %d", result.Iterations, max)
+			}
+		})
+	}
+}
+
+func TestSolveDiagnostics(t *testing.T) {
+	evaluations := 0
+	square := func(x Decimal) Decimal {
+		evaluations++
+		return x.Mul(x)
+	}
+	result, err := Solve(context.Background(), SolveOptions{Precision: 6}, NewInt(0), NewInt(3), NewInt(2), square)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Evaluations != evaluations || result.Iterations == 0 {
+		t.Errorf("got %d evaluations and %d iterations, want %d evaluations", result.Evaluations, result.Iterations, evaluations)
+	}
+	if !result.Left.EqualTo(result.Right, 6) || !result.Left.EqualTo(result.Value, 6) {
+		t.Errorf("final bracket [%v, %v] does not contain %v", result.Left, result.Right, result.Value)
+	}
+	if result.Residual.Abs().GreaterThan(NewScalar(1, 5)) {
+		t.Errorf("residual %v too large", result.Residual)
+	}
+}
+
+func TestSolveCancelled(t *testing.T) {
+	square := func(x Decimal) Decimal { return x.Mul(x) }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result, err := Solve(ctx, SolveOptions{}, NewInt(0), NewInt(3), NewInt(2), square)
+	if err != context.Canceled || result.Reason != Cancelled || result.Evaluations != 0 {
+		t.Errorf("cancelled: got (%d, %v) after %d evaluations", result.Reason, err, result.Evaluations)
+	}
+
+	ctx, cancel = context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	_, err = Solve(ctx, SolveOptions{}, NewInt(0), NewInt(3), NewInt(2), square)
+	if err != context.DeadlineExceeded {
+		t.Errorf("deadline: got %v", err)
+	}
+
+	// Cancel part way through a slowly converging search for the triple root of (x-1)^3.
+	for _, method := range []SolveMethod{Bisection, Brent, Illinois, Secant, Newton} {
+		ctx, cancel := context.WithCancel(context.Background())
+		evaluations := 0
+		f := func(x Decimal) Decimal {
+			if evaluations++; evaluations == 10 {
+				cancel()
+			}
+			return x.SubInt(1).PowInt(3)
+		}
+		result, err := Solve(ctx, SolveOptions{Method: method, Precision: 30}, NewInt(0), NewInt(3), NewInt(0), f)
+		if err != context.Canceled || result.Reason != Cancelled || result.Evaluations != 10 {
+			t.Errorf("method %d: got (%d, %v) after %d evaluations", method, result.Reason, err, result.Evaluations)
+		}
+		cancel()
+	}
+}
@@ -0,0 +1,153 @@
+package money
+
+import (
+	"math/big"
+	"sort"
+)
+
+// AllocationStrategy determines how the units left over after an allocation are distributed.
+//
+// Parts are first allocated their exact share rounded towards zero, in units of the last
+// decimal place. The strategy decides which parts receive the remaining units.
+// All strategies are deterministic.
+type AllocationStrategy int
+
+// Allocation strategies.
+const (
+	// LargestRemainder gives one unit each to the parts whose shares were rounded down the most,
+	// with ties going to the earlier part.
+	LargestRemainder AllocationStrategy = iota
+	// FirstPart gives all remaining units to the first part with a non-zero ratio.
+	FirstPart
+	// LastPart gives all remaining units to the last part with a non-zero ratio.
+	LastPart
+	// RoundRobin gives one unit each to the parts in order, skipping those with a zero ratio.
+	RoundRobin
+)
+
+// Split divides the decimal into n parts rounded to dp decimal places, which sum exactly to the original.
+//
+// Larger parts come first, e.g. 100.00 split three ways is 33.34, 33.33, 33.33.
+// If the decimal has more than dp decimal places, its own scale is used instead.
+// Split returns nil if n < 1 or the decimal is NaN or infinite.
+func (d Decimal) Split(n int, dp int) []Decimal {
+	return d.SplitWith(n, dp, RoundRobin)
+}
+
+// SplitWith is like Split but uses the given strategy to distribute any remainder.
+func (d Decimal) SplitWith(n int, dp int, strategy AllocationStrategy) []Decimal {
+	if n < 1 {
+		return nil
+	}
+	ratios := make([]*big.Rat, n)
+	for i := range ratios {
+		ratios[i] = big.NewRat(1, 1)
+	}
+	return allocate(d, dp, strategy, ratios)
+}
+
+// Allocate divides the decimal into parts in proportion to the given ratios,
+// which sum exactly to the original at its own scale.
+//
+// For example, 100.00 allocated 1:2 is 33.33, 66.67.
+// Ratios must be non-negative with a positive sum and the decimal must be finite,
+// otherwise nil is returned.
+func (d Decimal) Allocate(ratios ...Decimal) []Decimal {
+	return d.AllocateWith(LargestRemainder, ratios...)
+}
+
+// AllocateWith is like Allocate but uses the given strategy to distribute any remainder.
+func (d Decimal) AllocateWith(strategy AllocationStrategy, ratios ...Decimal) []Decimal {
+	rats := make([]*big.Rat, len(ratios))
+	for i, r := range ratios {
+		if r.value == nil {
+			rats[i] = new(big.Rat)
+			continue
+		}
+		if !r.value.IsFinite() || r.value.Signbit() {
+			return nil
+		}
+		rats[i] = r.value.Rat(nil)
+	}
+	return allocate(d, 0, strategy, rats)
+}
+
+func allocate(d Decimal, dp int, strategy AllocationStrategy, ratios []*big.Rat) []Decimal {
+	total := new(big.Rat)
+	for _, r := range ratios {
+		total.Add(total, r)
+	}
+	if len(ratios) == 0 || total.Sign() <= 0 || !d.val().IsFinite() {
+		return nil
+	}
+
+	// Work in whole units of the last decimal place.
+	var units *big.Int
+	if d.value == nil {
+		units = new(big.Int)
+	} else {
+		if scale := d.value.Scale(); scale > dp {
+			dp = scale
+		}
+		units = mantissa(d.value)
+		shift := big.NewInt(int64(dp - d.value.Scale()))
+		units.Mul(units, shift.Exp(big.NewInt(10), shift, nil))
+	}
+	neg := units.Sign() < 0
+	units.Abs(units)
+
+	parts := make([]*big.Int, len(ratios))
+	fracs := make([]*big.Rat, len(ratios))
+	left := new(big.Int).Set(units)
+	for i, r := range ratios {
+		share := new(big.Rat).SetInt(units)
+		share.Mul(share, r).Quo(share, total)
+		parts[i] = new(big.Int).Quo(share.Num(), share.Denom())
+		fracs[i] = share.Sub(share, new(big.Rat).SetInt(parts[i]))
+		left.Sub(left, parts[i])
+	}
+
+	distributeRemainder(parts, ratios, fracs, left, strategy)
+
+	result := make([]Decimal, len(parts))
+	for i, p := range parts {
+		if neg {
+			p.Neg(p)
+		}
+		result[i] = wrap(zero().SetBigMantScale(p, dp))
+	}
+	return result
+}
+
+// distributeRemainder adds the left over units to the parts according to the strategy.
+func distributeRemainder(parts []*big.Int, ratios, fracs []*big.Rat, left *big.Int, strategy AllocationStrategy) {
+	var eligible []int
+	for i, r := range ratios {
+		if r.Sign() > 0 {
+			eligible = append(eligible, i)
+		}
+	}
+
+	switch strategy {
+	case FirstPart:
+		parts[eligible[0]].Add(parts[eligible[0]], left)
+		return
+	case LastPart:
+		last := eligible[len(eligible)-1]
+		parts[last].Add(parts[last], left)
+		return
+	case LargestRemainder:
+		sort.SliceStable(eligible, func(a, b int) bool {
+			return fracs[eligible[a]].Cmp(fracs[eligible[b]]) > 0
+		})
+	}
+
+	// The remainder is always less than the number of eligible parts,
+	// since each part was rounded down by less than one unit.
+	one := big.NewInt(1)
+	for i := 0; left.Sign() > 0; i++ {
+		p := parts[eligible[i%len(eligible)]]
+		p.Add(p, one)
+		left.Sub(left, one)
+	}
+}
@@ -0,0 +1,147 @@
+package money
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleDecimal_Split() {
+	fmt.Println(New(100).Split(3, 2))
+	// Output: [33.34 33.33 33.33]
+}
+
+func ExampleDecimal_Allocate() {
+	fmt.Println(NewCents(10000).Allocate(NewInt(1), NewInt(2)))
+	fmt.Println(NewCents(5).Allocate(Pc(30), Pc(70)))
+	// Output:
+	// [33.33 66.67]
+	// [0.02 0.03]
+}
+
+func ExampleDecimal_AllocateWith() {
+	amount := NewCents(1000)
+	ratios := []Decimal{NewInt(1), NewInt(1), NewInt(1)}
+	for _, s := range []AllocationStrategy{LargestRemainder, FirstPart, LastPart, RoundRobin} {
+		fmt.Println(amount.AllocateWith(s, ratios...))
+	}
+	// Output:
+	// [3.34 3.33 3.33]
+	// [3.34 3.33 3.33]
+	// [3.33 3.33 3.34]
+	// [3.34 3.33 3.33]
+}
+
+func TestDecimal_Split(t *testing.T) {
+	for _, tc := range []struct {
+		d     Decimal
+		n, dp int
+		want  string
+	}{
+		{New(100), 3, 2, "[33.34 33.33 33.33]"},
+		{New(-100), 3, 2, "[-33.34 -33.33 -33.33]"},
+		{NewCents(2), 3, 2, "[0.01 0.01 0]"},
+		{NewInt(10), 4, 0, "[3 3 2 2]"},
+		{NewInt(10), 4, 1, "[2.5 2.5 2.5 2.5]"},
+		{NewScalar(1, 3), 2, 2, "[0.001 0]"},
+		{NewScalar(1, -2), 3, 0, "[34 33 33]"},
+		{Decimal{}, 2, 2, "[0 0]"},
+		{New(1), 1, 2, "[1.00]"},
+		{New(1), 0, 2, "[]"},
+	} {
+		t.Run(fmt.Sprintf("%v/%d", tc.d, tc.n), func(t *testing.T) {
+			got := tc.d.Split(tc.n, tc.dp)
+			if s := fmt.Sprint(got); s != tc.want {
+				t.Errorf("wanted %s, got %s", tc.want, s)
+			}
+			assertSum(t, tc.d, got)
+		})
+	}
+}
+
+func TestDecimal_AllocateWith(t *testing.T) {
+	ratios := []Decimal{Pc(20), NewInt(0), Pc(35), Pc(45)}
+	for _, tc := range []struct {
+		strategy AllocationStrategy
+		want     string
+	}{
+		{LargestRemainder, "[0.21 0 0.36 0.46]"},
+		{FirstPart, "[0.21 0 0.36 0.46]"},
+		{LastPart, "[0.20 0 0.36 0.47]"},
+		{RoundRobin, "[0.21 0 0.36 0.46]"},
+	} {
+		t.Run(fmt.Sprint(tc.strategy), func(t *testing.T) {
+			d := NewCents(103)
+			got := d.AllocateWith(tc.strategy, ratios...)
+			if s := fmt.Sprint(got); s != tc.want {
+				t.Errorf("wanted %s, got %s", tc.want, s)
+			}
+			assertSum(t, d, got)
+		})
+	}
+}
+
+func TestDecimal_AllocateLargestRemainder(t *testing.T) {
+	got := NewCents(100).Allocate(NewInt(1), NewInt(1), NewInt(4))
+	if s := fmt.Sprint(got); s != "[0.17 0.17 0.66]" {
+		t.Errorf("got %s", s)
+	}
+
+	got = NewCents(100).AllocateWith(RoundRobin, NewInt(1), NewInt(1), NewInt(4))
+	if s := fmt.Sprint(got); s != "[0.17 0.17 0.66]" {
+		t.Errorf("got %s", s)
+	}
+
+	got = NewCents(100).AllocateWith(FirstPart, NewInt(1), NewInt(1), NewInt(4))
+	if s := fmt.Sprint(got); s != "[0.18 0.16 0.66]" {
+		t.Errorf("got %s", s)
+	}
+
+	got = NewCents(100).AllocateWith(LastPart, NewInt(1), NewInt(1), NewInt(4))
+	if s := fmt.Sprint(got); s != "[0.16 0.16 0.68]" {
+		t.Errorf("got %s", s)
+	}
+
+	got = NewCents(1).Allocate(NewInt(1), NewInt(3))
+	if s := fmt.Sprint(got); s != "[0 0.01]" {
+		t.Errorf("got %s", s)
+	}
+}
+
+func TestDecimal_AllocateInvalid(t *testing.T) {
+	for _, ratios := range [][]Decimal{
+		nil,
+		{NewInt(0)},
+		{NewInt(1), NewInt(-1)},
+		{wrap(zero().SetInf(false))},
+	} {
+		if got := New(1).Allocate(ratios...); got != nil {
+			t.Errorf("%v: wanted nil, got %v", ratios, got)
+		}
+	}
+
+	for _, d := range []Decimal{wrap(zero().SetNaN(false)), wrap(zero().SetInf(false)), wrap(zero().SetInf(true))} {
+		if got := d.Allocate(NewInt(1)); got != nil {
+			t.Errorf("%v allocated: wanted nil, got %v", d, got)
+		}
+		if got := d.Split(2, 2); got != nil {
+			t.Errorf("%v split: wanted nil, got %v", d, got)
+		}
+	}
+}
+
+func assertSum(t *testing.T, want Decimal, parts []Decimal) {
+	t.Helper()
+	if len(parts) == 0 {
+		return
+	}
+	sum := parts[0]
+	for _, p := range parts[1:] {
+		sum = sum.Add(p)
+	}
+	if want.value == nil {
+		want = NewInt(0)
+	}
+	if !sum.Equals(want) {
+		t.Errorf("parts sum to %v, wanted %v", sum, want)
+	}
+}
@@ -0,0 +1,72 @@
+package money
+
+// Loan describes a loan to be amortized with level payments at the end of each period.
+type Loan struct {
+	Principal Decimal // amount borrowed
+	Rate      Decimal // interest rate per period
+	Periods   int     // total number of periods, including any interest-only periods
+
+	// InterestOnly is the number of initial periods in which only interest is paid.
+	InterestOnly int
+
+	// Balloon is an optional lump sum of principal repaid with the final payment.
+	Balloon Decimal
+
+	DP       int          // decimal places each row is rounded to, e.g. 2 for cents
+	Rounding RoundingMode // rounding mode used for each row
+}
+
+// AmortizationRow is a single period in an amortization schedule.
+type AmortizationRow struct {
+	Period    int     // period number, starting from 1
+	Payment   Decimal // total amount paid in the period
+	Interest  Decimal // portion of the payment which is interest
+	Principal Decimal // portion of the payment which repays the principal
+	Balance   Decimal // principal outstanding at the end of the period
+}
+
+// Amortize calculates the repayment schedule of a loan.
+//
+// Interest and the level payment are rounded to DP decimal places using the loan's rounding mode.
+// The final payment is adjusted to repay the outstanding balance (including any balloon),
+// so the schedule always ends with a balance of exactly zero.
+func Amortize(loan Loan) []AmortizationRow {
+	if loan.Periods < 1 {
+		return nil
+	}
+
+	round := func(d Decimal) Decimal { return quantize(d, loan.DP, loan.Rounding) }
+	balloon := orZero(loan.Balloon)
+
+	var payment Decimal
+	if amortizing := loan.Periods - loan.InterestOnly; amortizing > 0 {
+		payment = round(Payment(loan.Rate, amortizing, loan.Principal, balloon.Neg(), EndOfPeriod).Neg())
+	}
+
+	rows := make([]AmortizationRow, loan.Periods)
+	balance := loan.Principal
+	for i := range rows {
+		interest := round(balance.Mul(loan.Rate))
+
+		var principal Decimal
+		switch {
+		case i == len(rows)-1:
+			principal = balance
+		case i < loan.InterestOnly:
+			principal = NewInt(0)
+		default:
+			principal = payment.Sub(interest)
+		}
+
+		balance = balance.Sub(principal)
+		rows[i] = AmortizationRow{
+			Period:    i + 1,
+			Payment:   interest.Add(principal),
+			Interest:  interest,
+			Principal: principal,
+			Balance:   balance,
+		}
+	}
+
+	return rows
+}
@@ -0,0 +1,156 @@
+package money
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleAmortize() {
+	schedule := Amortize(Loan{
+		Principal: New(1000),
+		Rate:      Pc(1),
+		Periods:   4,
+		DP:        2,
+		Rounding:  ToNearestAway,
+	})
+	for _, row := range schedule {
+		fmt.Printf("%d %.2f %.2f %.2f %.2f\n", row.Period, row.Payment, row.Interest, row.Principal, row.Balance)
+	}
+	// Output:
+	// 1 256.28 10.00 246.28 753.72
+	// 2 256.28 7.54 248.74 504.98
+	// 3 256.28 5.05 251.23 253.75
+	// 4 256.29 2.54 253.75 0.00
+}
+
+func TestAmortize(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		loan      Loan
+		payments  []Decimal // expected payments, the last of which repeats up to the final payment
+		final     Decimal
+		interest  Decimal
+		remaining map[int]Decimal
+	}{
+		{
+			name:     "mortgage",
+			loan:     Loan{Principal: New(200000), Rate: Pc(5).Div(NewInt(12)), Periods: 360, DP: 2, Rounding: ToNearestEven},
+			payments: []Decimal{NewCents(107364)},
+			final:    NewCents(107648),
+			interest: NewCents(18651324),
+			remaining: map[int]Decimal{
+				1:   NewCents(19975969),
+				120: NewCents(16268471),
+			},
+		},
+		{
+			name:     "interest only",
+			loan:     Loan{Principal: New(12000), Rate: Pc(1), Periods: 12, InterestOnly: 12, DP: 2},
+			payments: []Decimal{New(120)},
+			final:    New(12120),
+			interest: New(1440),
+		},
+		{
+			name:     "interest only then repayment",
+			loan:     Loan{Principal: New(1000), Rate: Pc(1), Periods: 4, InterestOnly: 2, DP: 2, Rounding: ToNearestAway},
+			payments: []Decimal{New(10), New(10), NewCents(50751)},
+			final:    NewCents(50751),
+			interest: NewCents(3502),
+			remaining: map[int]Decimal{
+				2: New(1000),
+				3: NewCents(50249),
+			},
+		},
+		{
+			name:     "balloon",
+			loan:     Loan{Principal: New(10000), Rate: Pc(1), Periods: 12, Balloon: New(5000), DP: 2, Rounding: ToNearestEven},
+			payments: []Decimal{NewCents(49424)},
+			final:    NewCents(549429),
+			interest: NewCents(93093),
+		},
+		{
+			name:     "zero rate",
+			loan:     Loan{Principal: New(100), Rate: NewInt(0), Periods: 3, DP: 2, Rounding: ToNearestEven},
+			payments: []Decimal{NewCents(3333)},
+			final:    NewCents(3334),
+			interest: NewInt(0),
+		},
+		{
+			name:     "whole units",
+			loan:     Loan{Principal: NewInt(100000), Rate: Pc(1), Periods: 6, DP: 0, Rounding: ToPositiveInf},
+			payments: []Decimal{NewInt(17255)},
+			final:    NewInt(17256),
+			interest: NewInt(3531),
+		},
+		{
+			name:     "small balance",
+			loan:     Loan{Principal: NewCents(1), Rate: Pc(10), Periods: 3, DP: 2},
+			payments: []Decimal{NewInt(0)},
+			final:    NewCents(1),
+			interest: NewInt(0),
+		},
+		{
+			name:     "whole units from cents",
+			loan:     Loan{Principal: New(1000), Rate: Pc(1), Periods: 3, DP: 0},
+			payments: []Decimal{NewInt(340)},
+			final:    NewInt(340),
+			interest: NewInt(20),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rows := Amortize(tc.loan)
+			if len(rows) != tc.loan.Periods {
+				t.Fatalf("wanted %d rows, got %d", tc.loan.Periods, len(rows))
+			}
+
+			interest, principal := NewInt(0), NewInt(0)
+			for i, row := range rows {
+				if row.Period != i+1 {
+					t.Errorf("row %d has period %d", i, row.Period)
+				}
+				if rounded := row.Payment.RoundDP(tc.loan.DP, ToZero); !row.Payment.Equals(rounded) {
+					t.Errorf("period %d: payment %v is not rounded to %d dp", row.Period, row.Payment, tc.loan.DP)
+				}
+				if rounded := row.Interest.RoundDP(tc.loan.DP, ToZero); !row.Interest.Equals(rounded) {
+					t.Errorf("period %d: interest %v is not rounded to %d dp", row.Period, row.Interest, tc.loan.DP)
+				}
+				if !row.Payment.Equals(row.Interest.Add(row.Principal)) {
+					t.Errorf("period %d: payment %v != %v + %v", row.Period, row.Payment, row.Interest, row.Principal)
+				}
+
+				want := tc.final
+				if i < len(rows)-1 {
+					want = tc.payments[len(tc.payments)-1]
+					if i < len(tc.payments) {
+						want = tc.payments[i]
+					}
+				}
+				if !row.Payment.Equals(want) {
+					t.Errorf("period %d: wanted payment %v, got %v", row.Period, want, row.Payment)
+				}
+				if want, ok := tc.remaining[row.Period]; ok && !row.Balance.Equals(want) {
+					t.Errorf("period %d: wanted balance %v, got %v", row.Period, want, row.Balance)
+				}
+
+				interest = interest.Add(row.Interest)
+				principal = principal.Add(row.Principal)
+			}
+
+			if last := rows[len(rows)-1].Balance; last.value.Sign() != 0 {
+				t.Errorf("final balance %v", last)
+			}
+			if !principal.Equals(tc.loan.Principal) {
+				t.Errorf("principal repaid %v, wanted %v", principal, tc.loan.Principal)
+			}
+			if !interest.Equals(tc.interest) {
+				t.Errorf("total interest %v, wanted %v", interest, tc.interest)
+			}
+		})
+	}
+}
+
+func TestAmortizeNoPeriods(t *testing.T) {
+	if rows := Amortize(Loan{Principal: New(1), Rate: Pc(1)}); rows != nil {
+		t.Errorf("wanted nil, got %v", rows)
+	}
+}
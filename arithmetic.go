@@ -2,7 +2,7 @@ package money
 
 // Add calculates a + b.
 func (a Decimal) Add(b Decimal) Decimal {
-	return wrap(zero().Add(a.value, b.value))
+	return defaultContext.Add(a, b)
 }
 
 // AddInt calculates a + b.
@@ -12,7 +12,7 @@ func (a Decimal) AddInt(b int) Decimal {
 
 // Sub calculates a - b.
 func (a Decimal) Sub(b Decimal) Decimal {
-	return wrap(zero().Sub(a.value, b.value))
+	return defaultContext.Sub(a, b)
 }
 
 // SubInt calculates a - b.
@@ -22,10 +22,20 @@ func (a Decimal) SubInt(b int) Decimal {
 
 // Mul calculates a * b.
 func (a Decimal) Mul(b Decimal) Decimal {
-	return wrap(zero().Mul(a.value, b.value))
+	return defaultContext.Mul(a, b)
 }
 
 // Div calculates a / b.
 func (a Decimal) Div(b Decimal) Decimal {
-	return wrap(zero().Quo(a.value, b.value))
+	return defaultContext.Div(a, b)
+}
+
+// Abs calculates |d|.
+func (d Decimal) Abs() Decimal {
+	return wrap(zero().Abs(d.val()))
+}
+
+// Neg calculates -d.
+func (d Decimal) Neg() Decimal {
+	return wrap(zero().Neg(d.val()))
 }
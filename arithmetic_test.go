@@ -68,3 +68,13 @@ func TestMul(t *testing.T) {
 		})
 	}
 }
+
+func ExampleDecimal_Abs() {
+	fmt.Println(NewCents(-150).Abs(), NewCents(150).Abs(), Decimal{}.Abs())
+	// Output: 1.50 1.50 0
+}
+
+func ExampleDecimal_Neg() {
+	fmt.Println(NewCents(-150).Neg(), NewCents(150).Neg(), Decimal{}.Neg())
+	// Output: 1.50 -1.50 0
+}
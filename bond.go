@@ -0,0 +1,180 @@
+package money
+
+import "time"
+
+// Bond is a fixed coupon bond, redeemed at face value on maturity.
+//
+// Coupons are paid Frequency times a year, on dates rolled back from Maturity
+// in whole months without business day adjustment. A first coupon period
+// which starts at Issue may be shorter than the others, and pays a coupon prorated
+// in the same way as accrued interest.
+//
+// Frequency defaults to 1. For any other frequency which does not divide 12, prices,
+// coupons and accrued interest are NaN, coupon dates are zero and there are no cash flows.
+//
+// Prices are in the same units as Face, so use a face value of 100 for prices as a percentage of par.
+type Bond struct {
+	Face       Decimal
+	CouponRate Decimal // annual coupon rate
+	Frequency  int     // coupon payments per year: 1, 2, 3, 4, 6 or 12
+	Issue      time.Time
+	Maturity   time.Time
+
+	// DayCount is used for accrued interest and the fraction of the coupon period to the next
+	// coupon. If nil, Actual/Actual ICMA is used with coupon dates rolled from Maturity.
+	DayCount DayCount
+}
+
+// Coupon returns the amount paid for each regular coupon period, i.e. Face * CouponRate / Frequency.
+func (b Bond) Coupon() Decimal {
+	if b.frequency() == 0 {
+		return nan()
+	}
+	return b.Face.Mul(b.CouponRate).Div(NewInt(b.frequency()))
+}
+
+// PreviousCoupon returns the last coupon date on or before settlement, or Issue if there is none.
+func (b Bond) PreviousCoupon(settlement time.Time) time.Time {
+	if b.frequency() == 0 {
+		return time.Time{}
+	}
+	prev, _ := b.period(settlement)
+	if prev.Before(b.Issue) {
+		return b.Issue
+	}
+	return prev
+}
+
+// NextCoupon returns the first coupon date after settlement.
+func (b Bond) NextCoupon(settlement time.Time) time.Time {
+	if b.frequency() == 0 {
+		return time.Time{}
+	}
+	_, next := b.period(settlement)
+	return next
+}
+
+// CashFlows returns the coupons and redemption paid after settlement.
+func (b Bond) CashFlows(settlement time.Time) []CashFlow {
+	if b.frequency() == 0 {
+		return nil
+	}
+	var flows []CashFlow
+	coupon := b.Coupon()
+	for _, d := range b.couponDates(settlement) {
+		flows = append(flows, CashFlow{d, coupon})
+	}
+	n := len(flows)
+	if n == 0 {
+		return nil
+	}
+	if start, _ := b.period(flows[0].Date.AddDate(0, 0, -1)); start.Before(b.Issue) {
+		flows[0].Amount = b.Face.Mul(b.CouponRate).Mul(b.dayCount().YearFraction(b.Issue, flows[0].Date))
+	}
+	flows[n-1].Amount = flows[n-1].Amount.Add(b.Face)
+	return flows
+}
+
+// AccruedInterest calculates the interest earned since the previous coupon, i.e. Face * CouponRate * t,
+// where t is the year fraction from the previous coupon (or Issue) to settlement.
+func (b Bond) AccruedInterest(settlement time.Time) Decimal {
+	if b.frequency() == 0 {
+		return nan()
+	}
+	return b.Face.Mul(b.CouponRate).Mul(b.dayCount().YearFraction(b.PreviousCoupon(settlement), settlement))
+}
+
+// DirtyPrice calculates the price including accrued interest for an annual yield to maturity,
+// compounded with the coupon frequency.
+//
+// This is Σ cashflow[k] / (1 + yield/Frequency)^(k + w), where w is the fraction of the
+// coupon period from settlement to the next coupon, and k counts from zero.
+func (b Bond) DirtyPrice(settlement time.Time, yield Decimal) Decimal {
+	if b.frequency() == 0 {
+		return nan()
+	}
+	prev, next := b.period(settlement)
+	dc := b.dayCount()
+	w := dc.YearFraction(settlement, next).Div(dc.YearFraction(prev, next))
+	factor := yield.Div(NewInt(b.frequency())).AddInt(1)
+
+	sum := NewInt(0)
+	for k, f := range b.CashFlows(settlement) {
+		sum = sum.Add(f.Amount.Div(factor.Pow(w.AddInt(k))))
+	}
+	return sum
+}
+
+// CleanPrice calculates the price excluding accrued interest for an annual yield to maturity.
+func (b Bond) CleanPrice(settlement time.Time, yield Decimal) Decimal {
+	if b.frequency() == 0 {
+		return nan()
+	}
+	return b.DirtyToClean(settlement, b.DirtyPrice(settlement, yield))
+}
+
+// YieldToMaturity calculates the annual yield, compounded with the coupon frequency, for a clean price.
+//
+// The yield is found using GoalSeek to 12 significant figures. ErrNoConvergence is returned
+// if no yield could be found, or the frequency is not supported.
+func (b Bond) YieldToMaturity(settlement time.Time, cleanPrice Decimal) (Decimal, error) {
+	if b.frequency() == 0 {
+		return nan(), ErrNoConvergence
+	}
+	dirty := b.CleanToDirty(settlement, cleanPrice)
+	f := func(yield Decimal) Decimal { return b.DirtyPrice(settlement, yield).Sub(dirty) }
+	return seekBracketed(Pc(-99), NewInt(1), 12, f)
+}
+
+// CleanToDirty adds the accrued interest to a clean price.
+func (b Bond) CleanToDirty(settlement time.Time, clean Decimal) Decimal {
+	if b.frequency() == 0 {
+		return nan()
+	}
+	return clean.Add(b.AccruedInterest(settlement))
+}
+
+// DirtyToClean subtracts the accrued interest from a dirty price.
+func (b Bond) DirtyToClean(settlement time.Time, dirty Decimal) Decimal {
+	if b.frequency() == 0 {
+		return nan()
+	}
+	return dirty.Sub(b.AccruedInterest(settlement))
+}
+
+func (b Bond) dayCount() DayCount {
+	if b.DayCount == nil {
+		return ActualActualICMA{Frequency: b.frequency(), Reference: b.Maturity}
+	}
+	return b.DayCount
+}
+
+// frequency returns the number of coupons a year, or 0 if Frequency is not supported.
+func (b Bond) frequency() int { return couponFrequency(b.Frequency) }
+
+func (b Bond) step() int { return 12 / b.frequency() }
+
+// period returns the regular coupon period containing settlement, which may start before Issue.
+func (b Bond) period(settlement time.Time) (time.Time, time.Time) {
+	n := -(monthsBetween(settlement, b.Maturity)/b.step() + 1)
+	for !couponDate(b.Maturity, (n+1)*b.step()).After(settlement) {
+		n++
+	}
+	for couponDate(b.Maturity, n*b.step()).After(settlement) {
+		n--
+	}
+	return couponDate(b.Maturity, n*b.step()), couponDate(b.Maturity, (n+1)*b.step())
+}
+
+// couponDates returns the coupon dates after settlement, up to and including Maturity.
+func (b Bond) couponDates(settlement time.Time) []time.Time {
+	var dates []time.Time
+	for k := 0; ; k++ {
+		d := couponDate(b.Maturity, -k*b.step())
+		if !d.After(settlement) || !d.After(b.Issue) {
+			break
+		}
+		dates = append([]time.Time{d}, dates...)
+	}
+	return dates
+}
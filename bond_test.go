@@ -0,0 +1,186 @@
+package money
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func ExampleBond_CleanPrice() {
+	b := Bond{
+		Face:       New(100),
+		CouponRate: Bp(575),
+		Frequency:  2,
+		Issue:      date(2007, time.November, 15),
+		Maturity:   date(2017, time.November, 15),
+		DayCount:   Thirty360US{},
+	}
+	settlement := date(2008, time.February, 15)
+	fmt.Println(b.CleanPrice(settlement, Pm(65)).RoundDP(5, ToNearestEven))
+	fmt.Printf("%.4f\n", b.AccruedInterest(settlement))
+	// Output:
+	// 94.63436
+	// 1.4375
+}
+
+func ExampleBond_YieldToMaturity() {
+	b := Bond{
+		Face:       New(100),
+		CouponRate: Bp(575),
+		Frequency:  2,
+		Issue:      date(2007, time.November, 15),
+		Maturity:   date(2016, time.November, 15),
+		DayCount:   Thirty360US{},
+	}
+	yield, err := b.YieldToMaturity(date(2008, time.February, 15), NewScalar(9504287, 5))
+	fmt.Println(yield.RoundDP(6, ToNearestEven), err)
+	// Output: 0.065000 <nil>
+}
+
+func TestBond_Schedule(t *testing.T) {
+	b := Bond{Face: New(1000), CouponRate: Pc(6), Frequency: 4, Issue: date(2020, 2, 10), Maturity: date(2021, 8, 31)}
+
+	for _, tc := range []struct {
+		settlement, prev, next time.Time
+		flows                  int
+	}{
+		{date(2020, 2, 10), date(2020, 2, 10), date(2020, 2, 29), 7},
+		{date(2020, 2, 29), date(2020, 2, 29), date(2020, 5, 31), 6},
+		{date(2020, 3, 15), date(2020, 2, 29), date(2020, 5, 31), 6},
+		{date(2021, 5, 31), date(2021, 5, 31), date(2021, 8, 31), 1},
+		{date(2021, 8, 30), date(2021, 5, 31), date(2021, 8, 31), 1},
+	} {
+		t.Run(tc.settlement.Format("2006-01-02"), func(t *testing.T) {
+			if got := b.PreviousCoupon(tc.settlement); !got.Equal(tc.prev) {
+				t.Errorf("previous: wanted %v, got %v", tc.prev, got)
+			}
+			if got := b.NextCoupon(tc.settlement); !got.Equal(tc.next) {
+				t.Errorf("next: wanted %v, got %v", tc.next, got)
+			}
+			flows := b.CashFlows(tc.settlement)
+			if len(flows) != tc.flows {
+				t.Fatalf("wanted %d flows, got %d", tc.flows, len(flows))
+			}
+			if !flows[0].Date.Equal(tc.next) || !flows[len(flows)-1].Date.Equal(b.Maturity) {
+				t.Errorf("unexpected flow dates %v", flows)
+			}
+			if last := flows[len(flows)-1].Amount; !last.Equals(New(1015)) {
+				t.Errorf("wanted final flow 1015, got %v", last)
+			}
+		})
+	}
+}
+
+// Prices in these tests are from a spreadsheet, except for Actual/360 where spreadsheets
+// use a nominal 90 day quarter, rounded to the displayed precision.
+func TestBond_Price(t *testing.T) {
+	for i, tc := range []struct {
+		bond       Bond
+		settlement time.Time
+		yield      Decimal
+		clean      Decimal
+		accrued    Decimal
+	}{
+		{
+			Bond{New(100), Bp(575), 2, date(2007, 11, 15), date(2017, 11, 15), Thirty360US{}},
+			date(2008, 2, 15), Pm(65), NewScalar(9463436, 5), NewScalar(14375, 4),
+		},
+		{
+			Bond{New(100), Pc(5), 2, date(2010, 1, 1), date(2020, 1, 1), Thirty360US{}},
+			date(2010, 1, 1), Pc(5), New(100), NewInt(0),
+		},
+		{
+			Bond{New(100), Pc(5), 2, date(2010, 1, 1), date(2020, 1, 1), ActualActualISDA{}},
+			date(2015, 1, 1), Pc(6), NewScalar(9573490, 5), NewInt(0),
+		},
+		{
+			Bond{New(100), Pc(8), 4, date(2008, 11, 15), date(2018, 11, 15), Actual360{}},
+			date(2009, 1, 1), Pc(7), NewScalar(10705798, 5), NewScalar(1044444, 6),
+		},
+	} {
+		clean := tc.bond.CleanPrice(tc.settlement, tc.yield).RoundDP(tc.clean.value.Scale(), ToNearestEven)
+		accrued := tc.bond.AccruedInterest(tc.settlement).RoundDP(6, ToNearestEven)
+		if !clean.Equals(tc.clean) || !accrued.Equals(tc.accrued) {
+			t.Errorf("#%d wanted (%v, %v), got (%v, %v)", i, tc.clean, tc.accrued, clean, accrued)
+		}
+
+		yield, err := tc.bond.YieldToMaturity(tc.settlement, tc.bond.CleanPrice(tc.settlement, tc.yield))
+		if err != nil || !yield.EqualTo(tc.yield, 10) {
+			t.Errorf("#%d wanted yield %v, got %v (%v)", i, tc.yield, yield, err)
+		}
+	}
+}
+
+func TestBond_ICMA(t *testing.T) {
+	// A 4% semi-annual bond settled 3 months after a 184 day coupon period started.
+	b := Bond{Face: New(100), CouponRate: Pc(4), Frequency: 2, Issue: date(2020, 1, 15), Maturity: date(2025, 1, 15)}
+	settlement := date(2023, 10, 15)
+
+	accrued := b.AccruedInterest(settlement)
+	want := NewInt(2).Mul(NewInt(92)).Div(NewInt(184))
+	if !accrued.EqualTo(want, 20) {
+		t.Errorf("wanted accrued %v, got %v", want, accrued)
+	}
+
+	dirty := b.DirtyPrice(settlement, Pc(4))
+	if clean := b.DirtyToClean(settlement, dirty); !b.CleanToDirty(settlement, clean).Equals(dirty) {
+		t.Errorf("clean/dirty round trip failed")
+	}
+	if clean := b.CleanPrice(settlement, Pc(4)); !clean.EqualTo(dirty.Sub(accrued), 20) {
+		t.Errorf("wanted clean %v, got %v", dirty.Sub(accrued), clean)
+	}
+}
+
+func TestBond_Frequency(t *testing.T) {
+	settlement := date(2023, 10, 15)
+	bond := func(freq int) Bond {
+		return Bond{Face: New(100), CouponRate: Pc(4), Frequency: freq, Issue: date(2020, 1, 15), Maturity: date(2025, 1, 15)}
+	}
+
+	b, want := bond(0), bond(1)
+	if got, w := b.CashFlows(settlement), want.CashFlows(settlement); fmt.Sprint(got) != fmt.Sprint(w) {
+		t.Errorf("default frequency: wanted cash flows %v, got %v", w, got)
+	}
+	if got, w := b.CleanPrice(settlement, Pc(5)), want.CleanPrice(settlement, Pc(5)); !got.Equals(w) {
+		t.Errorf("default frequency: wanted clean price %v, got %v", w, got)
+	}
+
+	for _, freq := range []int{-2, 5, 7, 8, 24} {
+		b := bond(freq)
+		got := fmt.Sprint(b.Coupon(), b.AccruedInterest(settlement), b.DirtyPrice(settlement, Pc(5)), b.CleanPrice(settlement, Pc(5)))
+		if got != "NaN NaN NaN NaN" {
+			t.Errorf("frequency %d: wanted NaN, got %s", freq, got)
+		}
+		if flows := b.CashFlows(settlement); flows != nil {
+			t.Errorf("frequency %d: wanted no cash flows, got %v", freq, flows)
+		}
+		if next := b.NextCoupon(settlement); !next.IsZero() {
+			t.Errorf("frequency %d: wanted no next coupon, got %v", freq, next)
+		}
+		if _, err := b.YieldToMaturity(settlement, New(100)); err != ErrNoConvergence {
+			t.Errorf("frequency %d: wanted %v, got %v", freq, ErrNoConvergence, err)
+		}
+		if got := (ActualActualICMA{Frequency: freq}).YearFraction(date(2020, 1, 15), settlement); fmt.Sprint(got) != "NaN" {
+			t.Errorf("frequency %d: wanted NaN year fraction, got %v", freq, got)
+		}
+	}
+}
+
+func TestBond_ShortFirstCoupon(t *testing.T) {
+	b := Bond{Face: New(100), CouponRate: Pc(6), Frequency: 2, Issue: date(2020, 3, 15), Maturity: date(2022, 6, 15)}
+	flows := b.CashFlows(date(2020, 3, 15))
+	if len(flows) != 5 {
+		t.Fatalf("wanted 5 cash flows, got %v", flows)
+	}
+	// 92 of the 183 days from 15 December 2019 to 15 June 2020
+	want := NewInt(3).Mul(NewInt(92)).Div(NewInt(183))
+	if !flows[0].Amount.RoundDP(12, ToNearestEven).Equals(want.RoundDP(12, ToNearestEven)) {
+		t.Errorf("wanted first coupon %v, got %v", want, flows[0].Amount)
+	}
+	if !flows[1].Amount.Equals(New(3)) {
+		t.Errorf("wanted regular coupon 3, got %v", flows[1].Amount)
+	}
+	if accrued := b.AccruedInterest(date(2020, 6, 14)); !accrued.LessThan(flows[0].Amount) {
+		t.Errorf("accrued interest %v is not less than the first coupon %v", accrued, flows[0].Amount)
+	}
+}
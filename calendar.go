@@ -0,0 +1,327 @@
+package money
+
+import (
+	"sort"
+	"time"
+)
+
+// Calendar determines which days are business days.
+//
+// Dates are compared by calendar date in the location of each time, ignoring the time of day.
+// A calendar whose weekend is every day of the week has no business days, so dates are not
+// adjusted and holidays are not substituted.
+type Calendar struct {
+	Name     string
+	Weekend  []time.Weekday
+	Holidays []Holiday
+}
+
+// Holiday is a rule for a public holiday which occurs once each year.
+//
+// Holidays are created with FixedHoliday, EasterHoliday or WeekdayHoliday.
+type Holiday struct {
+	Name       string
+	Substitute Substitution // how the holiday is observed if it falls on a weekend
+	FromYear   int          // first year the holiday is observed, or zero for all years
+	date       func(year int) time.Time
+}
+
+// Substitution determines when a holiday that falls on a weekend is observed instead.
+type Substitution int
+
+// Substitution rules.
+const (
+	// NoSubstitute means the holiday is not observed on another day.
+	NoSubstitute Substitution = iota
+	// NextBusinessDay moves the holiday to the next day that is neither a weekend nor another holiday.
+	NextBusinessDay
+	// SundayToMonday moves a Sunday holiday to Monday, and a Saturday holiday is not observed.
+	SundayToMonday
+	// NearestWeekday moves a Saturday holiday to Friday and a Sunday holiday to Monday.
+	NearestWeekday
+)
+
+// RollConvention determines how a date which is not a business day is adjusted.
+type RollConvention int
+
+// Roll conventions.
+const (
+	// Unadjusted leaves the date unchanged.
+	Unadjusted RollConvention = iota
+	// Following moves to the next business day.
+	Following
+	// ModifiedFollowing moves to the next business day, unless that is in the next month,
+	// in which case it moves to the previous business day.
+	ModifiedFollowing
+	// Preceding moves to the previous business day.
+	Preceding
+	// ModifiedPreceding moves to the previous business day, unless that is in the previous month,
+	// in which case it moves to the next business day.
+	ModifiedPreceding
+)
+
+// FixedHoliday is a holiday on the same date each year e.g. Christmas Day.
+func FixedHoliday(name string, month time.Month, day int, substitute Substitution) Holiday {
+	return Holiday{Name: name, Substitute: substitute, date: func(year int) time.Time {
+		return date(year, month, day)
+	}}
+}
+
+// EasterHoliday is a holiday a number of days from Easter Sunday e.g. -2 for Good Friday.
+func EasterHoliday(name string, offset int) Holiday {
+	return Holiday{Name: name, date: func(year int) time.Time {
+		return easter(year).AddDate(0, 0, offset)
+	}}
+}
+
+// WeekdayHoliday is a holiday on the nth weekday of a month, e.g. the 4th Thursday of November.
+// If n is negative it counts back from the end of the month, e.g. -1 for the last Monday of May.
+//
+// n must be 1 to 5 or -1 to -5. The holiday is not observed in a year where the month has
+// no nth weekday, e.g. a month with only four Mondays has no 5th Monday.
+func WeekdayHoliday(name string, month time.Month, weekday time.Weekday, n int) Holiday {
+	return Holiday{Name: name, date: func(year int) time.Time {
+		var d time.Time
+		switch {
+		case n < -5 || n == 0 || n > 5:
+			return time.Time{}
+		case n < 0:
+			last := date(year, month+1, 0)
+			back := (int(last.Weekday()) - int(weekday) + 7) % 7
+			d = last.AddDate(0, 0, -back+(n+1)*7)
+		default:
+			first := date(year, month, 1)
+			forward := (int(weekday) - int(first.Weekday()) + 7) % 7
+			d = first.AddDate(0, 0, forward+(n-1)*7)
+		}
+		if d.Month() != month {
+			return time.Time{}
+		}
+		return d
+	}}
+}
+
+// From returns a copy of the holiday which is only observed from the given year.
+func (h Holiday) From(year int) Holiday {
+	h.FromYear = year
+	return h
+}
+
+// IsWeekend returns true if t falls on one of the calendar's weekend days.
+func (c Calendar) IsWeekend(t time.Time) bool {
+	for _, w := range c.Weekend {
+		if t.Weekday() == w {
+			return true
+		}
+	}
+	return false
+}
+
+// IsHoliday returns true if a holiday is observed on t.
+func (c Calendar) IsHoliday(t time.Time) bool {
+	day := dayNumber(t)
+	// Substitutes can cross the end of a year.
+	for y := t.Year() - 1; y <= t.Year()+1; y++ {
+		for _, h := range c.HolidayDates(y) {
+			if dayNumber(h) == day {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IsBusinessDay returns true if t is neither a weekend nor a holiday.
+func (c Calendar) IsBusinessDay(t time.Time) bool {
+	return !c.IsWeekend(t) && !c.IsHoliday(t)
+}
+
+// HolidayDates returns the observed dates of the holidays for a year, in order.
+//
+// Substitute days may fall outside the year.
+func (c Calendar) HolidayDates(year int) []time.Time {
+	var rules []Holiday
+	taken := map[int64]bool{}
+	for _, h := range c.Holidays {
+		if d := h.date(year); h.FromYear <= year && !d.IsZero() {
+			rules = append(rules, h)
+			taken[dayNumber(d)] = true
+		}
+	}
+
+	var observed []time.Time
+	for _, h := range rules {
+		d := h.date(year)
+		if c.IsWeekend(d) {
+			switch h.Substitute {
+			case NoSubstitute:
+			case NextBusinessDay:
+				if c.noBusinessDays() {
+					break
+				}
+				d = d.AddDate(0, 0, 1)
+				for c.IsWeekend(d) || taken[dayNumber(d)] {
+					d = d.AddDate(0, 0, 1)
+				}
+				taken[dayNumber(d)] = true
+			case SundayToMonday:
+				if d.Weekday() != time.Sunday {
+					continue
+				}
+				d = d.AddDate(0, 0, 1)
+			case NearestWeekday:
+				if d.Weekday() == time.Saturday {
+					d = d.AddDate(0, 0, -1)
+				} else {
+					d = d.AddDate(0, 0, 1)
+				}
+			}
+		}
+		observed = append(observed, d)
+	}
+
+	sort.Slice(observed, func(i, j int) bool { return observed[i].Before(observed[j]) })
+	return observed
+}
+
+// Roll adjusts t to a business day according to the convention.
+func (c Calendar) Roll(t time.Time, convention RollConvention) time.Time {
+	switch convention {
+	case Following:
+		return c.step(t, 1)
+	case Preceding:
+		return c.step(t, -1)
+	case ModifiedFollowing:
+		if r := c.step(t, 1); r.Month() == t.Month() {
+			return r
+		}
+		return c.step(t, -1)
+	case ModifiedPreceding:
+		if r := c.step(t, -1); r.Month() == t.Month() {
+			return r
+		}
+		return c.step(t, 1)
+	default:
+		return t
+	}
+}
+
+// AddBusinessDays moves t forward by n business days, or backwards if n is negative.
+// If t is not a business day, the first step moves to the adjacent business day.
+func (c Calendar) AddBusinessDays(t time.Time, n int) time.Time {
+	if c.noBusinessDays() {
+		return t
+	}
+	dir := 1
+	if n < 0 {
+		dir, n = -1, -n
+	}
+	for ; n > 0; n-- {
+		t = c.step(t.AddDate(0, 0, dir), dir)
+	}
+	return t
+}
+
+// Dates generates n dates, each months apart after start, adjusted by the roll convention.
+//
+// Unadjusted dates are calculated from start to avoid drift, e.g. monthly from 31 January
+// gives the last day of each month. This is suitable for payment dates of an amortization schedule.
+// Dates returns nil if n < 1.
+func (c Calendar) Dates(start time.Time, months, n int, convention RollConvention) []time.Time {
+	if n < 1 {
+		return nil
+	}
+	dates := make([]time.Time, n)
+	for i := range dates {
+		dates[i] = c.Roll(addMonths(start, (i+1)*months), convention)
+	}
+	return dates
+}
+
+// step moves t in the given direction until it is a business day.
+func (c Calendar) step(t time.Time, dir int) time.Time {
+	if c.noBusinessDays() {
+		return t
+	}
+	for !c.IsBusinessDay(t) {
+		t = t.AddDate(0, 0, dir)
+	}
+	return t
+}
+
+// noBusinessDays returns true if every day of the week is a weekend.
+func (c Calendar) noBusinessDays() bool {
+	days := map[time.Weekday]bool{}
+	for _, w := range c.Weekend {
+		days[w] = true
+	}
+	return len(days) == 7
+}
+
+// easter calculates the date of Easter Sunday in the Gregorian calendar.
+func easter(year int) time.Time {
+	a, b, c := year%19, year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return date(year, time.Month(month), day)
+}
+
+var weekend = []time.Weekday{time.Saturday, time.Sunday}
+
+// UKCalendar has the bank holidays of England and Wales.
+//
+// One-off holidays, such as for coronations and jubilees, are not included.
+var UKCalendar = Calendar{
+	Name:    "UK",
+	Weekend: weekend,
+	Holidays: []Holiday{
+		FixedHoliday("New Year's Day", time.January, 1, NextBusinessDay),
+		EasterHoliday("Good Friday", -2),
+		EasterHoliday("Easter Monday", 1),
+		WeekdayHoliday("Early May Bank Holiday", time.May, time.Monday, 1),
+		WeekdayHoliday("Spring Bank Holiday", time.May, time.Monday, -1),
+		WeekdayHoliday("Summer Bank Holiday", time.August, time.Monday, -1),
+		FixedHoliday("Christmas Day", time.December, 25, NextBusinessDay),
+		FixedHoliday("Boxing Day", time.December, 26, NextBusinessDay),
+	},
+}
+
+// USFederalReserveCalendar has the holidays observed by the US Federal Reserve.
+var USFederalReserveCalendar = Calendar{
+	Name:    "US Federal Reserve",
+	Weekend: weekend,
+	Holidays: []Holiday{
+		FixedHoliday("New Year's Day", time.January, 1, SundayToMonday),
+		WeekdayHoliday("Birthday of Martin Luther King, Jr.", time.January, time.Monday, 3),
+		WeekdayHoliday("Washington's Birthday", time.February, time.Monday, 3),
+		WeekdayHoliday("Memorial Day", time.May, time.Monday, -1),
+		FixedHoliday("Juneteenth National Independence Day", time.June, 19, SundayToMonday).From(2021),
+		FixedHoliday("Independence Day", time.July, 4, SundayToMonday),
+		WeekdayHoliday("Labor Day", time.September, time.Monday, 1),
+		WeekdayHoliday("Columbus Day", time.October, time.Monday, 2),
+		FixedHoliday("Veterans Day", time.November, 11, SundayToMonday),
+		WeekdayHoliday("Thanksgiving Day", time.November, time.Thursday, 4),
+		FixedHoliday("Christmas Day", time.December, 25, SundayToMonday),
+	},
+}
+
+// TARGET2Calendar has the closing days of the TARGET2 payment system used for the euro.
+var TARGET2Calendar = Calendar{
+	Name:    "TARGET2",
+	Weekend: weekend,
+	Holidays: []Holiday{
+		FixedHoliday("New Year's Day", time.January, 1, NoSubstitute),
+		EasterHoliday("Good Friday", -2),
+		EasterHoliday("Easter Monday", 1),
+		FixedHoliday("Labour Day", time.May, 1, NoSubstitute),
+		FixedHoliday("Christmas Day", time.December, 25, NoSubstitute),
+		FixedHoliday("Boxing Day", time.December, 26, NoSubstitute),
+	},
+}
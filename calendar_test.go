@@ -0,0 +1,187 @@
+package money
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func ExampleCalendar_Roll() {
+	saturday := date(2024, time.August, 31)
+	for _, conv := range []RollConvention{Unadjusted, Following, ModifiedFollowing, Preceding} {
+		fmt.Println(UKCalendar.Roll(saturday, conv).Format("Mon 2 Jan"))
+	}
+	// Output:
+	// Sat 31 Aug
+	// Mon 2 Sep
+	// Fri 30 Aug
+	// Fri 30 Aug
+}
+
+func ExampleCalendar_Dates() {
+	for _, d := range UKCalendar.Dates(date(2024, time.January, 31), 1, 4, ModifiedFollowing) {
+		fmt.Println(d.Format("Mon 2 Jan"))
+	}
+	// Output:
+	// Thu 29 Feb
+	// Thu 28 Mar
+	// Tue 30 Apr
+	// Fri 31 May
+}
+
+func TestCalendar_HolidayDates(t *testing.T) {
+	for _, tc := range []struct {
+		cal  Calendar
+		year int
+		want []string
+	}{
+		{UKCalendar, 2021, []string{"2021-01-01", "2021-04-02", "2021-04-05", "2021-05-03", "2021-05-31", "2021-08-30", "2021-12-27", "2021-12-28"}},
+		{UKCalendar, 2024, []string{"2024-01-01", "2024-03-29", "2024-04-01", "2024-05-06", "2024-05-27", "2024-08-26", "2024-12-25", "2024-12-26"}},
+		{UKCalendar, 2016, []string{"2016-01-01", "2016-03-25", "2016-03-28", "2016-05-02", "2016-05-30", "2016-08-29", "2016-12-26", "2016-12-27"}},
+		{UKCalendar, 2022, []string{"2022-01-03", "2022-04-15", "2022-04-18", "2022-05-02", "2022-05-30", "2022-08-29", "2022-12-26", "2022-12-27"}},
+		{USFederalReserveCalendar, 2020, []string{"2020-01-01", "2020-01-20", "2020-02-17", "2020-05-25", "2020-09-07", "2020-10-12", "2020-11-11", "2020-11-26", "2020-12-25"}},
+		{USFederalReserveCalendar, 2021, []string{"2021-01-01", "2021-01-18", "2021-02-15", "2021-05-31", "2021-07-05", "2021-09-06", "2021-10-11", "2021-11-11", "2021-11-25"}},
+		{USFederalReserveCalendar, 2022, []string{"2022-01-17", "2022-02-21", "2022-05-30", "2022-06-20", "2022-07-04", "2022-09-05", "2022-10-10", "2022-11-11", "2022-11-24", "2022-12-26"}},
+		{TARGET2Calendar, 2024, []string{"2024-01-01", "2024-03-29", "2024-04-01", "2024-05-01", "2024-12-25", "2024-12-26"}},
+		{TARGET2Calendar, 2022, []string{"2022-04-15", "2022-04-18", "2022-12-26"}},
+	} {
+		t.Run(fmt.Sprintf("%s %d", tc.cal.Name, tc.year), func(t *testing.T) {
+			var got []string
+			for _, d := range tc.cal.HolidayDates(tc.year) {
+				if tc.cal.IsWeekend(d) {
+					continue
+				}
+				got = append(got, d.Format("2006-01-02"))
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tc.want) {
+				t.Errorf("\nwanted %v\n   got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestEaster(t *testing.T) {
+	for year, want := range map[int]string{
+		1961: "1961-04-02",
+		2000: "2000-04-23",
+		2008: "2008-03-23",
+		2019: "2019-04-21",
+		2024: "2024-03-31",
+		2038: "2038-04-25",
+	} {
+		if got := easter(year).Format("2006-01-02"); got != want {
+			t.Errorf("%d: wanted %s, got %s", year, want, got)
+		}
+	}
+}
+
+func TestWeekdayHoliday(t *testing.T) {
+	for _, tc := range []struct {
+		h    Holiday
+		want string
+	}{
+		{WeekdayHoliday("", time.November, time.Thursday, 4), "2024-11-28"},
+		{WeekdayHoliday("", time.September, time.Monday, 1), "2024-09-02"},
+		{WeekdayHoliday("", time.May, time.Monday, -1), "2024-05-27"},
+		{WeekdayHoliday("", time.March, time.Sunday, -1), "2024-03-31"},
+		{WeekdayHoliday("", time.June, time.Saturday, 1), "2024-06-01"},
+		{WeekdayHoliday("", time.March, time.Friday, -2), "2024-03-22"},
+		{WeekdayHoliday("", time.September, time.Monday, 5), "2024-09-30"},
+		{WeekdayHoliday("", time.September, time.Monday, -5), "2024-09-02"},
+		{WeekdayHoliday("", time.November, time.Monday, 5), ""},
+		{WeekdayHoliday("", time.November, time.Monday, -5), ""},
+		{WeekdayHoliday("", time.November, time.Monday, 6), ""},
+		{WeekdayHoliday("", time.November, time.Monday, 0), ""},
+	} {
+		got := tc.h.date(2024)
+		if tc.want == "" && !got.IsZero() || tc.want != "" && got.Format("2006-01-02") != tc.want {
+			t.Errorf("wanted %q, got %v", tc.want, got)
+		}
+	}
+
+	cal := Calendar{Weekend: []time.Weekday{time.Saturday, time.Sunday}, Holidays: []Holiday{
+		WeekdayHoliday("", time.November, time.Monday, 5),
+		WeekdayHoliday("", time.December, time.Monday, 5),
+	}}
+	if got := fmt.Sprint(cal.HolidayDates(2024)); got != "[2024-12-30 00:00:00 +0000 UTC]" {
+		t.Errorf("wanted only the 5th Monday of December, got %s", got)
+	}
+}
+
+func TestCalendar_Roll(t *testing.T) {
+	cal := UKCalendar
+	for _, tc := range []struct {
+		in   time.Time
+		conv RollConvention
+		want string
+	}{
+		{date(2024, 3, 29), Following, "2024-04-02"},
+		{date(2024, 3, 29), ModifiedFollowing, "2024-03-28"},
+		{date(2024, 3, 29), Preceding, "2024-03-28"},
+		{date(2024, 3, 29), ModifiedPreceding, "2024-03-28"},
+		{date(2024, 6, 1), Preceding, "2024-05-31"},
+		{date(2024, 6, 1), ModifiedPreceding, "2024-06-03"},
+		{date(2024, 6, 1), Unadjusted, "2024-06-01"},
+		{date(2024, 6, 3), Following, "2024-06-03"},
+		{date(2021, 12, 25), Following, "2021-12-29"},
+	} {
+		if got := cal.Roll(tc.in, tc.conv).Format("2006-01-02"); got != tc.want {
+			t.Errorf("%v %d: wanted %s, got %s", tc.in.Format("2006-01-02"), tc.conv, tc.want, got)
+		}
+	}
+}
+
+func TestCalendar_AddBusinessDays(t *testing.T) {
+	for _, tc := range []struct {
+		in   time.Time
+		n    int
+		want string
+	}{
+		{date(2024, 3, 27), 1, "2024-03-28"},
+		{date(2024, 3, 28), 1, "2024-04-02"},
+		{date(2024, 3, 28), 3, "2024-04-04"},
+		{date(2024, 4, 2), -1, "2024-03-28"},
+		{date(2024, 3, 30), 0, "2024-03-30"},
+		{date(2024, 3, 30), 1, "2024-04-02"},
+	} {
+		if got := UKCalendar.AddBusinessDays(tc.in, tc.n).Format("2006-01-02"); got != tc.want {
+			t.Errorf("%v%+d: wanted %s, got %s", tc.in.Format("2006-01-02"), tc.n, tc.want, got)
+		}
+	}
+}
+
+func TestCalendar_IsHolidayAcrossYears(t *testing.T) {
+	cal := Calendar{Weekend: weekend, Holidays: []Holiday{FixedHoliday("New Year's Day", time.January, 1, NearestWeekday)}}
+	if !cal.IsHoliday(date(2021, 12, 31)) {
+		t.Error("expected 2022 new year's day to be observed in 2021")
+	}
+	if cal.IsBusinessDay(date(2021, 12, 31)) || !cal.IsBusinessDay(date(2021, 12, 30)) {
+		t.Error("unexpected business days")
+	}
+}
+
+func TestCalendar_Dates(t *testing.T) {
+	for _, n := range []int{0, -1} {
+		if got := UKCalendar.Dates(date(2024, time.January, 31), 1, n, Following); got != nil {
+			t.Errorf("%d dates: wanted nil, got %v", n, got)
+		}
+	}
+}
+
+func TestCalendar_NoBusinessDays(t *testing.T) {
+	every := []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday}
+	cal := Calendar{Weekend: every, Holidays: []Holiday{FixedHoliday("Christmas Day", time.December, 25, NextBusinessDay)}}
+	d := date(2024, time.March, 30)
+	if got := cal.Roll(d, Following); !got.Equal(d) {
+		t.Errorf("roll: wanted %v, got %v", d, got)
+	}
+	if got := cal.AddBusinessDays(d, 3); !got.Equal(d) {
+		t.Errorf("add business days: wanted %v, got %v", d, got)
+	}
+	if got := cal.Dates(d, 1, 2, ModifiedFollowing); len(got) != 2 || !got[1].Equal(date(2024, time.May, 30)) {
+		t.Errorf("dates: got %v", got)
+	}
+	if got := cal.HolidayDates(2024); len(got) != 1 || !got[0].Equal(date(2024, time.December, 25)) {
+		t.Errorf("holiday dates: got %v", got)
+	}
+}
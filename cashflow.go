@@ -0,0 +1,196 @@
+package money
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+// Cash flow analysis errors.
+var (
+	ErrNoSignChange  = errors.New("money: cash flows do not change sign")
+	ErrMultipleIRR   = errors.New("money: cash flows have multiple internal rates of return")
+	ErrNoConvergence = errors.New("money: no solution found")
+)
+
+// NPV calculates the net present value of periodic cash flows, as per the spreadsheet NPV function.
+//
+// This is Σ flows[i] / (1+rate)^(i+1), i.e. the first flow is discounted by one period.
+// To treat the first flow as occurring now, add it to the NPV of the remaining flows.
+func NPV(rate Decimal, flows []Decimal) Decimal {
+	return discount(rate, flows).Div(rate.AddInt(1))
+}
+
+// discount calculates Σ flows[i] / (1+rate)^i.
+func discount(rate Decimal, flows []Decimal) Decimal {
+	// Horner's method: (((f[n-1]/(1+r) + f[n-2])/(1+r) + ...) + f[0]
+	sum, factor := NewInt(0), rate.AddInt(1)
+	for i := len(flows) - 1; i >= 0; i-- {
+		sum = sum.Div(factor).Add(flows[i])
+	}
+	return sum
+}
+
+// IRR calculates the internal rate of return of periodic cash flows, as per the spreadsheet IRR function.
+//
+// This is the rate for which Σ flows[i] / (1+rate)^i = 0. The rate is found using GoalSeek
+// to 12 significant figures, searching from -99% up to 100% or twice the guess if larger,
+// and expanding the upper bound if necessary. A zero guess uses the spreadsheet default of 10%.
+//
+// ErrNoSignChange is returned if the flows are all positive or all negative, and
+// ErrMultipleIRR is returned if more than one rate is found in the search range.
+func IRR(flows []Decimal, guess Decimal) (Decimal, error) {
+	changes := signChanges(flows)
+	if changes == 0 {
+		return wrap(zero()), ErrNoSignChange
+	}
+
+	return seekRate(changes, guess, func(rate Decimal) Decimal { return discount(rate, flows) })
+}
+
+// MIRR calculates the modified internal rate of return, as per the spreadsheet MIRR function.
+//
+// Negative flows are discounted at financeRate and positive flows are compounded at reinvestRate,
+// giving (-FV(positive flows) / PV(negative flows))^(1/(n-1)) - 1.
+func MIRR(flows []Decimal, financeRate, reinvestRate Decimal) (Decimal, error) {
+	if signChanges(flows) == 0 {
+		return wrap(zero()), ErrNoSignChange
+	}
+
+	n := len(flows)
+	negative, positive := make([]Decimal, n), make([]Decimal, n)
+	for i, f := range flows {
+		negative[i], positive[i] = NewInt(0), NewInt(0)
+		if f.IsNegative() {
+			negative[i] = f
+		} else {
+			positive[i] = f
+		}
+	}
+
+	pv := discount(financeRate, negative)
+	fv := discount(reinvestRate, positive).Mul(reinvestRate.AddInt(1).PowInt(n - 1))
+
+	return fv.Div(pv.Neg()).PowFrac(1, n-1).SubInt(1), nil
+}
+
+// CashFlow is an amount paid or received on a particular date.
+type CashFlow struct {
+	Date   time.Time
+	Amount Decimal
+}
+
+// XNPV calculates the net present value of irregular cash flows, as per the spreadsheet XNPV function.
+//
+// Flows are discounted to the date of the first flow on an Actual/365 Fixed basis.
+func XNPV(rate Decimal, flows []CashFlow) Decimal {
+	return XNPVWith(Actual365Fixed{}, rate, flows)
+}
+
+// XNPVWith is like XNPV but uses the given day count convention.
+//
+// This is Σ amount[i] / (1+rate)^t[i], where t[i] is the year fraction from the first date to date[i].
+func XNPVWith(dc DayCount, rate Decimal, flows []CashFlow) Decimal {
+	sum, factor := NewInt(0), rate.AddInt(1)
+	for _, f := range flows {
+		t := dc.YearFraction(flows[0].Date, f.Date)
+		sum = sum.Add(f.Amount.Div(factor.Pow(t)))
+	}
+	return sum
+}
+
+// XIRR calculates the internal rate of return of irregular cash flows, as per the spreadsheet XIRR function.
+//
+// Flows are discounted on an Actual/365 Fixed basis. The search and errors are the same as for IRR;
+// in particular ErrNoConvergence is returned if GoalSeek fails to converge.
+func XIRR(flows []CashFlow, guess Decimal) (Decimal, error) {
+	return XIRRWith(Actual365Fixed{}, flows, guess)
+}
+
+// XIRRWith is like XIRR but uses the given day count convention.
+func XIRRWith(dc DayCount, flows []CashFlow, guess Decimal) (Decimal, error) {
+	// Descartes' rule of signs also applies to fractional exponents, taken in order.
+	sorted := append([]CashFlow(nil), flows...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Date.Before(sorted[j].Date) })
+
+	amounts := make([]Decimal, len(sorted))
+	for i, f := range sorted {
+		amounts[i] = f.Amount
+	}
+	changes := signChanges(amounts)
+	if changes == 0 {
+		return wrap(zero()), ErrNoSignChange
+	}
+	return seekRate(changes, guess, func(rate Decimal) Decimal { return XNPVWith(dc, rate, flows) })
+}
+
+// seekRate finds the rate at which the present value f is zero, as described for IRR.
+func seekRate(changes int, guess Decimal, f func(Decimal) Decimal) (Decimal, error) {
+	if guess.IsZero() {
+		guess = Pc(10)
+	}
+
+	// Bisection is unlikely to land exactly on the special case of zero.
+	if f(wrap(zero())).IsZero() {
+		return wrap(zero()), nil
+	}
+
+	min, max := Pc(-99), Max(NewInt(1), guess.Mul(NewInt(2)))
+
+	// A single change of sign in the flows guarantees a unique rate (Descartes' rule of signs),
+	// otherwise look for more than one root.
+	if changes > 1 && countRoots(min, max, 100, f) > 1 {
+		return wrap(zero()), ErrMultipleIRR
+	}
+
+	return seekBracketed(min, max, 12, f)
+}
+
+// signChanges counts the changes of sign in a series, ignoring zeros.
+func signChanges(flows []Decimal) int {
+	changes, last := 0, 0
+	for _, f := range flows {
+		s := f.Sign()
+		if s == 0 {
+			continue
+		}
+		if last != 0 && s != last {
+			changes++
+		}
+		last = s
+	}
+	return changes
+}
+
+// countRoots counts the changes of sign of f sampled at n+1 evenly spaced points in [min, max].
+func countRoots(min, max Decimal, n int, f func(Decimal) Decimal) int {
+	step := max.Sub(min).Div(NewInt(n))
+	roots, last := 0, f(min).Sign()
+	for i := 1; i <= n; i++ {
+		s := f(min.Add(step.Mul(NewInt(i)))).Sign()
+		if s != 0 && last != 0 && s != last {
+			roots++
+		}
+		if s != 0 {
+			last = s
+		}
+	}
+	return roots
+}
+
+// seekBracketed finds a root of f with GoalSeek, doubling max until f changes sign over [min, max].
+func seekBracketed(min, max Decimal, precision int, f func(Decimal) Decimal) (Decimal, error) {
+	fmin := f(min).Sign()
+	for i := 0; fmin*f(max).Sign() > 0; i++ {
+		if i == 10 {
+			return wrap(zero()), ErrNoConvergence
+		}
+		max = max.Mul(NewInt(2))
+	}
+
+	result, ok := GoalSeek(min, max, NewInt(0), precision, f)
+	if !ok {
+		return result, ErrNoConvergence
+	}
+	return result, nil
+}
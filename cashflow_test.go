@@ -0,0 +1,162 @@
+package money
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func ExampleNPV() {
+	flows := []Decimal{New(-10000), New(3000), New(4200), New(6800)}
+	fmt.Print(NPV(Pc(10), flows).RoundDP(2, ToNearestEven))
+	// Output: 1188.44
+}
+
+func ExampleIRR() {
+	flows := []Decimal{New(-70000), New(12000), New(15000), New(18000), New(21000), New(26000)}
+	irr, err := IRR(flows, Decimal{})
+	fmt.Print(irr.RoundDP(4, ToNearestEven), err)
+	// Output: 0.0866 <nil>
+}
+
+func ExampleMIRR() {
+	flows := []Decimal{New(-120000), New(39000), New(30000), New(21000), New(37000), New(46000)}
+	mirr, err := MIRR(flows, Pc(10), Pc(12))
+	fmt.Print(mirr.RoundDP(4, ToNearestEven), err)
+	// Output: 0.1261 <nil>
+}
+
+func ints(values ...int64) []Decimal {
+	ds := make([]Decimal, len(values))
+	for i, v := range values {
+		ds[i] = New(v)
+	}
+	return ds
+}
+
+// Values in these tests are from a spreadsheet, rounded to the displayed precision.
+func TestNPV(t *testing.T) {
+	for i, tc := range []struct {
+		rate     Decimal
+		flows    []Decimal
+		expected Decimal
+	}{
+		{Pc(10), ints(-10000, 3000, 4200, 6800), NewCents(118844)},
+		{Pc(8), ints(8000, 9200, 10000, 12000, 14500), NewCents(4192206)},
+		{Pc(0), ints(-100, 50, 50), NewInt(0)},
+		{Pc(5), nil, NewInt(0)},
+	} {
+		actual := NPV(tc.rate, tc.flows).RoundDP(2, ToNearestEven)
+		if !actual.Equals(tc.expected) {
+			t.Errorf("#%d expected %v, got %v", i, tc.expected, actual)
+		}
+	}
+}
+
+func TestIRR(t *testing.T) {
+	for i, tc := range []struct {
+		flows    []Decimal
+		guess    Decimal
+		expected Decimal
+		err      error
+	}{
+		{ints(-70000, 12000, 15000, 18000, 21000), Decimal{}, NewScalar(-212, 4), nil},
+		{ints(-70000, 12000, 15000, 18000, 21000, 26000), Decimal{}, NewScalar(866, 4), nil},
+		{ints(-70000, 12000, 15000), Pc(-10), NewScalar(-4435, 4), nil},
+		{ints(-100, 0, 0, 1000), Decimal{}, NewScalar(11544, 4), nil},
+		{ints(-100, 5000), Decimal{}, NewScalar(490000, 4), nil},
+		{ints(-100, 100), Decimal{}, NewInt(0), nil},
+		{ints(100, 100), Decimal{}, NewInt(0), ErrNoSignChange},
+		{ints(0, 0), Decimal{}, NewInt(0), ErrNoSignChange},
+		{ints(-100, 230, -132), Decimal{}, NewInt(0), ErrMultipleIRR},
+	} {
+		actual, err := IRR(tc.flows, tc.guess)
+		actual = actual.RoundDP(4, ToNearestEven)
+		if !actual.Equals(tc.expected) || err != tc.err {
+			t.Errorf("#%d expected (%v,%v), got (%v,%v)", i, tc.expected, tc.err, actual, err)
+		}
+	}
+}
+
+func TestMIRR(t *testing.T) {
+	for i, tc := range []struct {
+		flows             []Decimal
+		finance, reinvest Decimal
+		expected          Decimal
+		err               error
+	}{
+		{ints(-120000, 39000, 30000, 21000, 37000, 46000), Pc(10), Pc(12), NewScalar(1261, 4), nil},
+		{ints(-120000, 39000, 30000, 21000), Pc(10), Pc(12), NewScalar(-480, 4), nil},
+		{ints(-120000, 39000, 30000, 21000, 37000, 46000), Pc(10), Pc(14), NewScalar(1348, 4), nil},
+		{ints(100, 200), Pc(10), Pc(12), NewInt(0), ErrNoSignChange},
+		{[]Decimal{New(-1), {}, New(2)}, Pc(10), Pc(12), NewScalar(4142, 4), nil},
+	} {
+		actual, err := MIRR(tc.flows, tc.finance, tc.reinvest)
+		actual = actual.RoundDP(4, ToNearestEven)
+		if !actual.Equals(tc.expected) || err != tc.err {
+			t.Errorf("#%d expected (%v,%v), got (%v,%v)", i, tc.expected, tc.err, actual, err)
+		}
+	}
+}
+
+var xflows = []CashFlow{
+	{date(2008, time.January, 1), New(-10000)},
+	{date(2008, time.March, 1), New(2750)},
+	{date(2008, time.October, 30), New(4250)},
+	{date(2009, time.February, 15), New(3250)},
+	{date(2009, time.April, 1), New(2750)},
+}
+
+func ExampleXNPV() {
+	fmt.Print(XNPV(Pc(9), xflows).RoundDP(2, ToNearestEven))
+	// Output: 2086.65
+}
+
+func ExampleXIRR() {
+	irr, err := XIRR(xflows, Decimal{})
+	fmt.Print(irr.RoundDP(6, ToNearestEven), err)
+	// Output: 0.373363 <nil>
+}
+
+func TestXNPV(t *testing.T) {
+	for i, tc := range []struct {
+		dc       DayCount
+		rate     Decimal
+		flows    []CashFlow
+		expected Decimal
+	}{
+		{Actual365Fixed{}, Pc(9), xflows, NewCents(208665)},
+		{Actual365Fixed{}, Pc(0), xflows, New(3000)},
+		{Actual365Fixed{}, Pc(10), []CashFlow{{date(2020, 1, 1), New(-100)}, {date(2021, 1, 1), New(110)}}, NewCents(-3)},
+		{Thirty360European{}, Pc(10), []CashFlow{{date(2020, 1, 1), New(-100)}, {date(2021, 1, 1), New(110)}}, NewInt(0)},
+		{Actual365Fixed{}, Pc(10), []CashFlow{{date(2021, 1, 1), New(110)}, {date(2020, 1, 1), New(-100)}}, NewCents(-3)},
+		{Actual365Fixed{}, Pc(10), nil, NewInt(0)},
+	} {
+		actual := XNPVWith(tc.dc, tc.rate, tc.flows).RoundDP(2, ToNearestEven)
+		if !actual.Equals(tc.expected) {
+			t.Errorf("#%d expected %v, got %v", i, tc.expected, actual)
+		}
+	}
+}
+
+func TestXIRR(t *testing.T) {
+	for i, tc := range []struct {
+		dc       DayCount
+		flows    []CashFlow
+		expected Decimal
+		err      error
+	}{
+		{Actual365Fixed{}, xflows, NewScalar(373363, 6), nil},
+		{Actual365Fixed{}, []CashFlow{{date(2020, 1, 1), New(-100)}, {date(2021, 1, 1), New(110)}}, NewScalar(99714, 6), nil},
+		{Thirty360European{}, []CashFlow{{date(2020, 1, 1), New(-100)}, {date(2021, 1, 1), New(110)}}, NewScalar(100000, 6), nil},
+		{Actual365Fixed{}, []CashFlow{{date(2021, 1, 1), New(110)}, {date(2020, 1, 1), New(-100)}}, NewScalar(99714, 6), nil},
+		{Actual365Fixed{}, []CashFlow{{date(2020, 1, 1), New(100)}, {date(2021, 1, 1), New(110)}}, NewInt(0), ErrNoSignChange},
+		{Actual365Fixed{}, []CashFlow{{date(2020, 1, 1), New(-100)}, {date(2021, 1, 1), New(230)}, {date(2022, 1, 1), New(-132)}}, NewInt(0), ErrMultipleIRR},
+	} {
+		actual, err := XIRRWith(tc.dc, tc.flows, Decimal{})
+		actual = actual.RoundDP(6, ToNearestEven)
+		if !actual.Equals(tc.expected) || err != tc.err {
+			t.Errorf("#%d expected (%v,%v), got (%v,%v)", i, tc.expected, tc.err, actual, err)
+		}
+	}
+}
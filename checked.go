@@ -0,0 +1,200 @@
+package money
+
+import (
+	"errors"
+	"math/big"
+
+	eld "github.com/ericlagergren/decimal"
+	"github.com/ericlagergren/decimal/math"
+)
+
+// Condition is a set of exceptional conditions raised by an arithmetic operation.
+type Condition = eld.Condition
+
+// Conditions raised by arithmetic operations.
+const (
+	DivisionByZero     Condition = eld.DivisionByZero     // a non-zero value divided by zero
+	DivisionUndefined  Condition = eld.DivisionUndefined  // zero divided by zero
+	DivisionImpossible Condition = eld.DivisionImpossible // an integer division result too long for the precision
+	InvalidOperation   Condition = eld.InvalidOperation   // e.g. a NaN operand or the log of a negative number
+	Overflow           Condition = eld.Overflow           // the result is too large to represent
+	Underflow          Condition = eld.Underflow          // the result is too small to represent and inexact
+	Inexact            Condition = eld.Inexact            // the result was rounded and is not exact
+	Rounded            Condition = eld.Rounded            // the result was rounded, possibly without loss
+	Subnormal          Condition = eld.Subnormal          // the result is subnormal
+	Clamped            Condition = eld.Clamped            // the scale was altered to fit the representation
+)
+
+// DefaultTraps are the conditions reported as errors by Calc, unless the Context specifies otherwise.
+const DefaultTraps = DivisionByZero | DivisionUndefined | DivisionImpossible | InvalidOperation | Overflow | Underflow
+
+// Errors matched by an *ArithmeticError with errors.Is.
+var (
+	ErrDivisionByZero   = errors.New("money: division by zero")
+	ErrInvalidOperation = errors.New("money: invalid operation")
+	ErrOverflow         = errors.New("money: overflow")
+	ErrUnderflow        = errors.New("money: underflow")
+	ErrInexact          = errors.New("money: inexact result")
+)
+
+// ArithmeticError describes the trapped conditions raised by a checked operation.
+//
+// It matches ErrDivisionByZero, ErrInvalidOperation, ErrOverflow, ErrUnderflow or ErrInexact
+// with errors.Is according to its conditions. Division of zero by zero matches both
+// ErrDivisionByZero and ErrInvalidOperation.
+type ArithmeticError struct {
+	Op         string    // the operation, e.g. "div"
+	Conditions Condition // the trapped conditions
+}
+
+func (e *ArithmeticError) Error() string {
+	return "money: " + e.Op + ": " + e.Conditions.String()
+}
+
+// Is implements matching of the sentinel errors by errors.Is.
+func (e *ArithmeticError) Is(target error) bool {
+	switch target {
+	case ErrDivisionByZero:
+		return e.Conditions&(DivisionByZero|DivisionUndefined) != 0
+	case ErrInvalidOperation:
+		return e.Conditions&(InvalidOperation|DivisionUndefined|DivisionImpossible) != 0
+	case ErrOverflow:
+		return e.Conditions&Overflow != 0
+	case ErrUnderflow:
+		return e.Conditions&Underflow != 0
+	case ErrInexact:
+		return e.Conditions&Inexact != 0
+	}
+	return false
+}
+
+// Calc performs a chain of checked arithmetic operations, keeping the first error.
+//
+// Unlike the methods on Decimal, which silently produce NaN or infinite values (or panic,
+// in the case of 0/0), Calc reports any trapped condition as an *ArithmeticError.
+// Operations after the first error have no effect.
+//
+//	total, err := NewCalc(price).Mul(quantity).Div(units).Result()
+//
+// To trap other conditions, e.g. Inexact to detect results which have been rounded,
+// use Context.NewCalc.
+type Calc struct {
+	ctx        Context
+	value      Decimal
+	conditions Condition
+	err        error
+}
+
+// NewCalc starts a chain of checked operations with an initial value, using the default context.
+func NewCalc(d Decimal) Calc {
+	return defaultContext.NewCalc(d)
+}
+
+// CheckedDiv calculates d / other, returning an *ArithmeticError for division by zero
+// and any other of the DefaultTraps.
+func (d Decimal) CheckedDiv(other Decimal) (Decimal, error) {
+	return NewCalc(d).Div(other).Result()
+}
+
+// Add calculates c + d.
+func (c Calc) Add(d Decimal) Calc {
+	return c.apply("add", func(z, x dec) { z.Add(x, d.val()) }, d)
+}
+
+// Sub calculates c - d.
+func (c Calc) Sub(d Decimal) Calc {
+	return c.apply("sub", func(z, x dec) { z.Sub(x, d.val()) }, d)
+}
+
+// Mul calculates c * d.
+func (c Calc) Mul(d Decimal) Calc {
+	return c.apply("mul", func(z, x dec) { z.Mul(x, d.val()) }, d)
+}
+
+// Div calculates c / d.
+func (c Calc) Div(d Decimal) Calc {
+	return c.apply("div", func(z, x dec) { z.Quo(x, d.val()) }, d)
+}
+
+// Pow calculates c^n.
+func (c Calc) Pow(n Decimal) Calc {
+	return c.apply("pow", func(z, x dec) { math.Pow(z, x, n.val()) }, n)
+}
+
+// PowFrac calculates c^(num/denom).
+func (c Calc) PowFrac(num, denom int) Calc {
+	if denom == 0 {
+		return c.fail("pow", DivisionByZero)
+	}
+	n := c.ctx.zero().SetRat(big.NewRat(int64(num), int64(denom)))
+	return c.apply("pow", func(z, x dec) { math.Pow(z, x, n) })
+}
+
+// Log calculates the natural logarithm of c.
+func (c Calc) Log() Calc {
+	return c.apply("log", func(z, x dec) {
+		if x.Sign() == 0 {
+			// As in IEEE 754, the log of zero is -Inf and raises division by zero.
+			z.SetInf(true).Context.Conditions |= DivisionByZero
+			return
+		}
+		math.Log(z, x)
+	})
+}
+
+// Result returns the value of the calculation, or the first error.
+func (c Calc) Result() (Decimal, error) {
+	if c.err != nil {
+		return Decimal{}, c.err
+	}
+	return orZero(c.value), nil
+}
+
+// Err returns the first error in the calculation, if any.
+func (c Calc) Err() error { return c.err }
+
+// Conditions returns all conditions raised so far, whether or not they were trapped.
+func (c Calc) Conditions() Condition { return c.conditions }
+
+// apply performs an operation on the current value, recording any conditions raised.
+func (c Calc) apply(op string, f func(z, x dec), operands ...Decimal) Calc {
+	if c.err != nil {
+		return c
+	}
+	x := c.value.val()
+	for _, o := range append(operands, c.value) {
+		if o.val().IsNaN(0) {
+			return c.fail(op, InvalidOperation)
+		}
+	}
+
+	z := c.ctx.zero()
+	func() {
+		defer func() {
+			// In Go operating mode, operations which produce NaN panic after setting the conditions.
+			if r := recover(); r != nil {
+				if _, ok := r.(eld.ErrNaN); !ok {
+					panic(r)
+				}
+			}
+		}()
+		f(z, x)
+	}()
+
+	raised := z.Context.Conditions
+	z.Context.Conditions = 0
+	c.value = wrap(z)
+	if raised != 0 {
+		c = c.fail(op, raised)
+	}
+	return c
+}
+
+// fail records conditions, setting the error if any are trapped.
+func (c Calc) fail(op string, raised Condition) Calc {
+	c.conditions |= raised
+	if trapped := raised & c.ctx.traps(); trapped != 0 {
+		c.err = &ArithmeticError{Op: op, Conditions: trapped}
+	}
+	return c
+}
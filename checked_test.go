@@ -0,0 +1,92 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	eld "github.com/ericlagergren/decimal"
+)
+
+func ExampleDecimal_CheckedDiv() {
+	_, err := New(1).CheckedDiv(NewInt(0))
+	fmt.Println(err, errors.Is(err, ErrDivisionByZero))
+	// Output: money: div: division by zero true
+}
+
+func ExampleCalc() {
+	total, err := NewCalc(New(100)).Mul(NewInt(3)).Div(NewInt(4)).Result()
+	fmt.Println(total, err)
+
+	_, err = NewCalc(New(100)).Sub(New(100)).Div(NewInt(0)).Add(New(1)).Result()
+	fmt.Println(err)
+
+	// Output:
+	// 75 <nil>
+	// money: div: division undefined, invalid operation
+}
+
+func TestCalc(t *testing.T) {
+	nan := wrap(zero().SetNaN(false))
+	huge, tiny := NewScalar(1, -5000), NewScalar(1, eld.MaxScale)
+	for _, tc := range []struct {
+		name string
+		calc Calc
+		want string
+		errs []error
+	}{
+		{"exact", NewCalc(NewCents(150)).Mul(NewInt(2)).Sub(NewInt(1)).Add(Decimal{}), "2.00", nil},
+		{"inexact ignored", NewCalc(NewInt(1)).Div(NewInt(3)), "0.3333333333333333333333333333333334", nil},
+		{"inexact trapped", Context{Traps: Inexact}.NewCalc(NewInt(1)).Div(NewInt(3)), "", []error{ErrInexact}},
+		{"exact trapped", Context{Traps: Inexact}.NewCalc(NewInt(1)).Div(NewInt(4)), "0.25", nil},
+		{"divide by zero", NewCalc(NewInt(1)).Div(NewInt(0)), "", []error{ErrDivisionByZero}},
+		{"divide by nil", NewCalc(NewInt(1)).Div(Decimal{}), "", []error{ErrDivisionByZero}},
+		{"zero by zero", NewCalc(Decimal{}).Div(NewInt(0)), "", []error{ErrDivisionByZero, ErrInvalidOperation}},
+		{"negative fractional power", NewCalc(NewInt(-8)).PowFrac(1, 3), "", []error{ErrInvalidOperation}},
+		{"zero denominator", NewCalc(NewInt(8)).PowFrac(1, 0), "", []error{ErrDivisionByZero}},
+		{"power", NewCalc(NewInt(2)).Pow(NewInt(10)), "1024", nil},
+		{"log of negative", NewCalc(NewInt(-1)).Log(), "", []error{ErrInvalidOperation}},
+		{"log of zero", NewCalc(NewInt(0)).Log(), "", []error{ErrDivisionByZero}},
+		{"nan operand", NewCalc(NewInt(1)).Add(nan), "", []error{ErrInvalidOperation}},
+		{"nan value", NewCalc(nan).Add(NewInt(1)), "", []error{ErrInvalidOperation}},
+		{"overflow", NewCalc(huge).Mul(huge), "", []error{ErrOverflow}},
+		{"underflow", NewCalc(tiny).Mul(tiny), "", []error{ErrUnderflow}},
+		{"first error kept", NewCalc(NewInt(1)).Div(NewInt(0)).Log().Mul(nan), "", []error{ErrDivisionByZero}},
+		{"untrapped infinity", Context{Traps: Inexact}.NewCalc(NewInt(1)).Div(NewInt(0)), "+Inf", nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.calc.Result()
+			if len(tc.errs) == 0 {
+				if err != nil || fmt.Sprint(got) != tc.want {
+					t.Errorf("wanted %s, got %v, %v", tc.want, got, err)
+				}
+				return
+			}
+			var ae *ArithmeticError
+			if !errors.As(err, &ae) {
+				t.Fatalf("wanted *ArithmeticError, got %v, %v", got, err)
+			}
+			for _, want := range tc.errs {
+				if !errors.Is(err, want) {
+					t.Errorf("wanted %v to match %v", err, want)
+				}
+			}
+			if tc.calc.Err() != err {
+				t.Errorf("Err() = %v, Result() = %v", tc.calc.Err(), err)
+			}
+		})
+	}
+}
+
+func TestCalc_Conditions(t *testing.T) {
+	c := NewCalc(NewInt(2)).Div(NewInt(3)).Mul(NewInt(3))
+	if err := c.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if c.Conditions()&Inexact == 0 || c.Conditions()&DivisionByZero != 0 {
+		t.Errorf("wanted inexact, got %v", c.Conditions())
+	}
+	if errors.Is(&ArithmeticError{Op: "div", Conditions: Inexact}, ErrDivisionByZero) {
+		t.Error("inexact matched division by zero")
+	}
+}
@@ -0,0 +1,107 @@
+package money
+
+import (
+	"math/big"
+
+	eld "github.com/ericlagergren/decimal"
+	"github.com/ericlagergren/decimal/math"
+)
+
+// Context determines the precision and rounding of inexact results, and the conditions
+// reported as errors by checked operations.
+//
+// Addition, subtraction and multiplication are always exact. Division, powers and logarithms
+// are rounded to Precision significant figures using Rounding.
+//
+// The zero Context has 34 significant figures, rounds to nearest even and traps DefaultTraps,
+// the same as the default used by the methods on Decimal and the functions in this package.
+// To use another precision or rounding mode, call the methods on a Context, or start a Calc
+// with Context.NewCalc.
+type Context struct {
+	Precision int          // significant figures of inexact results, or 34 if zero
+	Rounding  RoundingMode // rounding mode of inexact results
+	Traps     Condition    // conditions reported as errors by Calc, or DefaultTraps if zero
+}
+
+// defaultContext is used by the methods on Decimal, by NewCalc and by the functions in this package.
+// It is never changed.
+var defaultContext = Context{Precision: 34, Rounding: ToNearestEven, Traps: DefaultTraps}
+
+// Add calculates a + b.
+func (c Context) Add(a, b Decimal) Decimal {
+	return wrap(c.zero().Add(a.val(), b.val()))
+}
+
+// Sub calculates a - b.
+func (c Context) Sub(a, b Decimal) Decimal {
+	return wrap(c.zero().Sub(a.val(), b.val()))
+}
+
+// Mul calculates a * b.
+func (c Context) Mul(a, b Decimal) Decimal {
+	return wrap(c.zero().Mul(a.val(), b.val()))
+}
+
+// Div calculates a / b.
+func (c Context) Div(a, b Decimal) Decimal {
+	return wrap(c.zero().Quo(a.val(), b.val()))
+}
+
+// Pow calculates d^n.
+func (c Context) Pow(d, n Decimal) Decimal {
+	return wrap(math.Pow(c.zero(), d.val(), n.val()))
+}
+
+// PowInt calculates d^i.
+func (c Context) PowInt(d Decimal, i int) Decimal {
+	n := c.zero().SetUint64(uint64(i))
+	return wrap(math.Pow(c.zero(), d.val(), n))
+}
+
+// PowFrac calculates d^(num/denom).
+func (c Context) PowFrac(d Decimal, num, denom int) Decimal {
+	n := c.zero().SetRat(big.NewRat(int64(num), int64(denom)))
+	return wrap(math.Pow(c.zero(), d.val(), n))
+}
+
+// Log calculates the natural logarithm of d.
+func (c Context) Log(d Decimal) Decimal {
+	z := c.zero()
+	// The underlying package returns its ln(10) constant unrounded.
+	return wrap(math.Log(z, d.val()).Round(z.Context.Precision))
+}
+
+// Round rounds the decimal to the specified number of significant figures.
+func (c Context) Round(d Decimal, sigfigs int) Decimal {
+	return d.Round(sigfigs, c.Rounding)
+}
+
+// RoundDP rounds the decimal to the specified number of decimal places.
+func (c Context) RoundDP(d Decimal, dp int) Decimal {
+	return d.RoundDP(dp, c.Rounding)
+}
+
+// NewCalc starts a chain of checked operations with an initial value,
+// using the context's precision, rounding and traps.
+func (c Context) NewCalc(d Decimal) Calc {
+	return Calc{ctx: c, value: d}
+}
+
+// zero returns a new zero value which performs operations in this context.
+func (c Context) zero() dec {
+	z := new(eld.Big)
+	z.Context = eld.Context128
+	z.Context.OperatingMode = eld.Go
+	z.Context.RoundingMode = c.Rounding
+	if c.Precision > 0 {
+		z.Context.Precision = c.Precision
+	}
+	return z
+}
+
+func (c Context) traps() Condition {
+	if c.Traps == 0 {
+		return DefaultTraps
+	}
+	return c.Traps
+}
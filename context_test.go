@@ -0,0 +1,90 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExampleContext() {
+	ctx := Context{Precision: 5, Rounding: ToZero}
+	fmt.Println(ctx.Div(NewInt(2), NewInt(3)))
+	fmt.Println(ctx.Mul(New(1234), NewInt(3)))
+	// Output:
+	// 0.66666
+	// 3702.00
+}
+
+func ExampleContext_NewCalc() {
+	ctx := Context{Traps: DefaultTraps | Inexact}
+	_, err := ctx.NewCalc(New(100)).Div(NewInt(3)).Result()
+	fmt.Println(err, errors.Is(err, ErrInexact))
+	// Output: money: div: inexact true
+}
+
+func TestContext(t *testing.T) {
+	two, three := NewInt(2), NewInt(3)
+	for _, tc := range []struct {
+		name string
+		got  Decimal
+		want string
+	}{
+		{"zero context div", Context{}.Div(two, three), "0.6666666666666666666666666666666667"},
+		{"precision", Context{Precision: 4}.Div(two, three), "0.6667"},
+		{"rounding", Context{Precision: 4, Rounding: ToZero}.Div(two, three), "0.6666"},
+		{"add exact", Context{Precision: 2}.Add(NewCents(12345), NewCents(1)), "123.46"},
+		{"sub exact", Context{Precision: 2}.Sub(NewCents(12345), NewCents(1)), "123.44"},
+		{"mul exact", Context{Precision: 2}.Mul(NewCents(12345), three), "370.35"},
+		{"nil operands", Context{}.Add(Decimal{}, Decimal{}), "0"},
+		{"pow", Context{Precision: 6}.Pow(two, NewScalar(5, 1)), "1.41421"},
+		{"pow int", Context{}.PowInt(two, 10), "1024"},
+		{"pow frac", Context{Precision: 6}.PowFrac(two, 1, 3), "1.25992"},
+		{"log", Context{Precision: 6}.Log(NewInt(10)), "2.30259"},
+		{"round", Context{Rounding: ToPositiveInf}.Round(NewScalar(1231, 3), 2), "1.3"},
+		{"round dp", Context{Rounding: AwayFromZero}.RoundDP(NewCents(125), 1), "1.3"},
+		{"round dp even", Context{}.RoundDP(NewCents(125), 1), "1.2"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := fmt.Sprint(tc.got); got != tc.want {
+				t.Errorf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestContextCalc(t *testing.T) {
+	got, err := Context{Precision: 3}.NewCalc(NewInt(1)).Div(NewInt(7)).Result()
+	if err != nil || fmt.Sprint(got) != "0.143" {
+		t.Errorf("got %v, %v, want 0.143, <nil>", got, err)
+	}
+
+	_, err = Context{Traps: Inexact}.NewCalc(NewInt(1)).Div(NewInt(0)).Result()
+	if err != nil {
+		t.Errorf("untrapped division by zero: got %v", err)
+	}
+}
+
+func TestDefaultContext(t *testing.T) {
+	want := fmt.Sprint(Context{}.Div(NewInt(1), NewInt(3)))
+	if got := fmt.Sprint(NewInt(1).Div(NewInt(3))); got != want {
+		t.Errorf("default context differs from the zero context: %s", got)
+	}
+
+	ctx := Context{Precision: 4, Rounding: ToPositiveInf, Traps: Inexact}
+	if got := fmt.Sprint(ctx.Div(NewInt(1), NewInt(3))); got != "0.3334" {
+		t.Errorf("Div: got %s, want 0.3334", got)
+	}
+	if got := fmt.Sprint(ctx.PowFrac(NewInt(2), 1, 2)); got != "1.415" {
+		t.Errorf("PowFrac: got %s, want 1.415", got)
+	}
+	if _, err := ctx.NewCalc(NewInt(1)).Div(NewInt(3)).Result(); !errors.Is(err, ErrInexact) {
+		t.Errorf("NewCalc: got %v, want inexact error", err)
+	}
+
+	if got := fmt.Sprint(NewInt(1).Div(NewInt(3))); got != want {
+		t.Errorf("default context changed by another context: %s", got)
+	}
+	if _, err := NewCalc(NewInt(1)).Div(NewInt(3)).Result(); err != nil {
+		t.Errorf("default context changed by another context: %v", err)
+	}
+}
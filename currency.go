@@ -0,0 +1,136 @@
+package money
+
+import "sort"
+
+// Currency describes an ISO 4217 currency.
+type Currency struct {
+	Code       string // alphabetic code e.g. "GBP"
+	Numeric    string // numeric code e.g. "826"
+	MinorUnits int    // number of decimal places of the minor unit e.g. 2 for pence
+	Symbol     string // common symbol e.g. "£"
+}
+
+// String returns the alphabetic code.
+func (c Currency) String() string { return c.Code }
+
+// LookupCurrency finds a currency in the embedded table by its alphabetic code.
+//
+// Currencies not in the table (e.g. crypto assets) can be declared directly as Currency values.
+func LookupCurrency(code string) (Currency, bool) {
+	c, ok := currencies[code]
+	return c, ok
+}
+
+// MustCurrency is like LookupCurrency but panics if the currency is not found.
+func MustCurrency(code string) Currency {
+	c, ok := LookupCurrency(code)
+	if !ok {
+		panic("money: unknown currency " + code)
+	}
+	return c
+}
+
+// Currencies returns the alphabetic codes of all currencies in the embedded table.
+func Currencies() []string {
+	codes := make([]string, 0, len(currencies))
+	for code := range currencies {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// CashIncrement returns the smallest amount that can be paid in cash, e.g. 0.05 for CHF.
+// This is the minor unit unless the currency is in the embedded table of cash rounding rules.
+func (c Currency) CashIncrement() Decimal {
+	if inc, ok := cashIncrements[c.Code]; ok {
+		return inc
+	}
+	return NewScalar(1, c.MinorUnits)
+}
+
+var currencies = map[string]Currency{}
+
+// cashIncrements are the currencies whose smallest coin is larger than the minor unit.
+var cashIncrements = map[string]Decimal{
+	"AUD": NewCents(5),
+	"CAD": NewCents(5),
+	"CHF": NewCents(5),
+	"CZK": NewCents(100),
+	"DKK": NewCents(50),
+	"HUF": NewCents(500),
+	"NOK": NewCents(100),
+	"NZD": NewCents(10),
+	"SEK": NewCents(100),
+}
+
+func init() {
+	for _, c := range []Currency{
+		{"AED", "784", 2, "د.إ"},
+		{"ARS", "032", 2, "$"},
+		{"AUD", "036", 2, "A$"},
+		{"BGN", "975", 2, "лв"},
+		{"BHD", "048", 3, ".د.ب"},
+		{"BIF", "108", 0, "FBu"},
+		{"BRL", "986", 2, "R$"},
+		{"CAD", "124", 2, "CA$"},
+		{"CHF", "756", 2, "CHF"},
+		{"CLF", "990", 4, "UF"},
+		{"CLP", "152", 0, "$"},
+		{"CNY", "156", 2, "¥"},
+		{"COP", "170", 2, "$"},
+		{"CZK", "203", 2, "Kč"},
+		{"DJF", "262", 0, "Fdj"},
+		{"DKK", "208", 2, "kr"},
+		{"EGP", "818", 2, "E£"},
+		{"EUR", "978", 2, "€"},
+		{"GBP", "826", 2, "£"},
+		{"GNF", "324", 0, "FG"},
+		{"HKD", "344", 2, "HK$"},
+		{"HUF", "348", 2, "Ft"},
+		{"IDR", "360", 2, "Rp"},
+		{"ILS", "376", 2, "₪"},
+		{"INR", "356", 2, "₹"},
+		{"IQD", "368", 3, "ع.د"},
+		{"ISK", "352", 0, "kr"},
+		{"JOD", "400", 3, "د.ا"},
+		{"JPY", "392", 0, "¥"},
+		{"KES", "404", 2, "KSh"},
+		{"KMF", "174", 0, "CF"},
+		{"KRW", "410", 0, "₩"},
+		{"KWD", "414", 3, "د.ك"},
+		{"LYD", "434", 3, "ل.د"},
+		{"MXN", "484", 2, "MX$"},
+		{"MYR", "458", 2, "RM"},
+		{"NGN", "566", 2, "₦"},
+		{"NOK", "578", 2, "kr"},
+		{"NZD", "554", 2, "NZ$"},
+		{"OMR", "512", 3, "ر.ع."},
+		{"PHP", "608", 2, "₱"},
+		{"PKR", "586", 2, "₨"},
+		{"PLN", "985", 2, "zł"},
+		{"PYG", "600", 0, "₲"},
+		{"QAR", "634", 2, "ر.ق"},
+		{"RON", "946", 2, "lei"},
+		{"RUB", "643", 2, "₽"},
+		{"RWF", "646", 0, "FRw"},
+		{"SAR", "682", 2, "ر.س"},
+		{"SEK", "752", 2, "kr"},
+		{"SGD", "702", 2, "S$"},
+		{"THB", "764", 2, "฿"},
+		{"TND", "788", 3, "د.ت"},
+		{"TRY", "949", 2, "₺"},
+		{"TWD", "901", 2, "NT$"},
+		{"UAH", "980", 2, "₴"},
+		{"UGX", "800", 0, "USh"},
+		{"USD", "840", 2, "$"},
+		{"VND", "704", 0, "₫"},
+		{"VUV", "548", 0, "VT"},
+		{"XAF", "950", 0, "FCFA"},
+		{"XOF", "952", 0, "CFA"},
+		{"XPF", "953", 0, "₣"},
+		{"ZAR", "710", 2, "R"},
+	} {
+		currencies[c.Code] = c
+	}
+}
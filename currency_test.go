@@ -0,0 +1,47 @@
+package money
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleLookupCurrency() {
+	c, ok := LookupCurrency("KWD")
+	fmt.Println(c.Code, c.Numeric, c.MinorUnits, ok)
+	// Output: KWD 414 3 true
+}
+
+func TestCurrencyTable(t *testing.T) {
+	numerics := map[string]string{}
+	for _, code := range Currencies() {
+		c := MustCurrency(code)
+		if c.Code != code || len(c.Code) != 3 || len(c.Numeric) != 3 || c.Symbol == "" {
+			t.Errorf("invalid currency %+v", c)
+		}
+		if other, ok := numerics[c.Numeric]; ok {
+			t.Errorf("%s and %s share numeric code %s", code, other, c.Numeric)
+		}
+		numerics[c.Numeric] = code
+	}
+
+	for code, units := range map[string]int{"GBP": 2, "JPY": 0, "BHD": 3, "KWD": 3, "CLF": 4} {
+		if c := MustCurrency(code); c.MinorUnits != units {
+			t.Errorf("%s: wanted %d minor units, got %d", code, units, c.MinorUnits)
+		}
+	}
+
+	for code, inc := range cashIncrements {
+		c, ok := LookupCurrency(code)
+		minor := NewScalar(1, c.MinorUnits)
+		if !ok || !inc.RoundTo(minor, ToZero).Equals(inc) || inc.LessThan(minor) {
+			t.Errorf("%s: invalid cash increment %v", code, inc)
+		}
+	}
+	if inc := MustCurrency("JPY").CashIncrement(); !inc.Equals(NewInt(1)) {
+		t.Errorf("JPY: wanted cash increment 1, got %v", inc)
+	}
+
+	if _, ok := LookupCurrency("XXX"); ok {
+		t.Error("unexpected currency XXX")
+	}
+}
@@ -0,0 +1,283 @@
+package money
+
+import "time"
+
+// DayCount is a convention for calculating the fraction of a year between two dates.
+//
+// Year fractions are calculated from calendar dates in the location of each time,
+// ignoring the time of day.
+type DayCount interface {
+	// YearFraction returns the number of years from start to end,
+	// which is negative if end is before start.
+	YearFraction(start, end time.Time) Decimal
+}
+
+// Thirty360US is the 30/360 US convention (also known as 30U/360), with the
+// end of February adjustments used in the US securities industry.
+type Thirty360US struct{}
+
+// YearFraction implements DayCount.
+func (Thirty360US) YearFraction(start, end time.Time) Decimal {
+	if end.Before(start) {
+		return Thirty360US{}.YearFraction(end, start).Neg()
+	}
+	y1, m1, d1 := start.Date()
+	y2, m2, d2 := end.Date()
+	if isLastOfFebruary(start) {
+		if isLastOfFebruary(end) {
+			d2 = 30
+		}
+		d1 = 30
+	}
+	if d2 == 31 && d1 >= 30 {
+		d2 = 30
+	}
+	if d1 == 31 {
+		d1 = 30
+	}
+	return thirty360(y1, y2, int(m1), int(m2), d1, d2)
+}
+
+// Thirty360European is the 30E/360 convention (also known as Eurobond basis).
+type Thirty360European struct{}
+
+// YearFraction implements DayCount.
+func (Thirty360European) YearFraction(start, end time.Time) Decimal {
+	if end.Before(start) {
+		return Thirty360European{}.YearFraction(end, start).Neg()
+	}
+	y1, m1, d1 := start.Date()
+	y2, m2, d2 := end.Date()
+	if d1 == 31 {
+		d1 = 30
+	}
+	if d2 == 31 {
+		d2 = 30
+	}
+	return thirty360(y1, y2, int(m1), int(m2), d1, d2)
+}
+
+// Thirty360EPlus is the 30E+/360 convention, where an end date on the 31st
+// is moved to the 1st of the following month.
+type Thirty360EPlus struct{}
+
+// YearFraction implements DayCount.
+func (Thirty360EPlus) YearFraction(start, end time.Time) Decimal {
+	if end.Before(start) {
+		return Thirty360EPlus{}.YearFraction(end, start).Neg()
+	}
+	y1, m1, d1 := start.Date()
+	y2, m2, d2 := end.Date()
+	if d1 == 31 {
+		d1 = 30
+	}
+	if d2 == 31 {
+		d2, m2 = 1, m2+1
+	}
+	return thirty360(y1, y2, int(m1), int(m2), d1, d2)
+}
+
+// Actual360 counts the actual number of days, in a year of 360 days.
+type Actual360 struct{}
+
+// YearFraction implements DayCount.
+func (Actual360) YearFraction(start, end time.Time) Decimal {
+	return NewInt(daysBetween(start, end)).Div(NewInt(360))
+}
+
+// Actual365Fixed counts the actual number of days, in a year of 365 days.
+type Actual365Fixed struct{}
+
+// YearFraction implements DayCount.
+func (Actual365Fixed) YearFraction(start, end time.Time) Decimal {
+	return NewInt(daysBetween(start, end)).Div(NewInt(365))
+}
+
+// Actual365L counts the actual number of days, in a year of 365 or 366 days (also known as Actual/365 Leap year).
+//
+// For annual frequencies the year has 366 days if the period includes 29 February,
+// otherwise it has 366 days if the end date is in a leap year.
+type Actual365L struct {
+	Frequency int // coupon payments per year
+}
+
+// YearFraction implements DayCount.
+func (dc Actual365L) YearFraction(start, end time.Time) Decimal {
+	if end.Before(start) {
+		return dc.YearFraction(end, start).Neg()
+	}
+	basis := 365
+	if dc.Frequency == 1 {
+		if includesLeapDay(start, end) {
+			basis = 366
+		}
+	} else if isLeap(end.Year()) {
+		basis = 366
+	}
+	return NewInt(daysBetween(start, end)).Div(NewInt(basis))
+}
+
+// ActualActualISDA counts the actual number of days, where days in leap years
+// are counted in a year of 366 days and others in a year of 365 days.
+type ActualActualISDA struct{}
+
+// YearFraction implements DayCount.
+func (ActualActualISDA) YearFraction(start, end time.Time) Decimal {
+	if end.Before(start) {
+		return ActualActualISDA{}.YearFraction(end, start).Neg()
+	}
+	sum := NewInt(0)
+	for y := start.Year(); y <= end.Year(); y++ {
+		from, to := date(y, time.January, 1), date(y+1, time.January, 1)
+		if y == start.Year() {
+			from = start
+		}
+		if y == end.Year() {
+			to = end
+		}
+		basis := 365
+		if isLeap(y) {
+			basis = 366
+		}
+		sum = sum.Add(NewInt(daysBetween(from, to)).Div(NewInt(basis)))
+	}
+	return sum
+}
+
+// ActualActualICMA counts the actual number of days in each coupon period,
+// where each period is 1/Frequency years long (also known as Actual/Actual ISMA).
+//
+// Coupon periods are a whole number of months apart, on the same day of the month
+// as Reference, or the end of the month if it is shorter. If Reference is zero, the
+// end date is used. Irregular (stub) periods are measured against notional regular periods.
+//
+// Frequency defaults to 1. The year fraction is NaN for any other frequency which does not divide 12.
+type ActualActualICMA struct {
+	Frequency int       // coupon payments per year: 1, 2, 3, 4, 6 or 12
+	Reference time.Time // any coupon date
+}
+
+// YearFraction implements DayCount.
+func (dc ActualActualICMA) YearFraction(start, end time.Time) Decimal {
+	freq := couponFrequency(dc.Frequency)
+	if freq == 0 {
+		return nan()
+	}
+	if end.Before(start) {
+		return dc.YearFraction(end, start).Neg()
+	}
+
+	step := 12 / freq
+	ref := dc.Reference
+	if ref.IsZero() {
+		ref = end
+	}
+
+	// Find the coupon period containing start.
+	n := monthsBetween(ref, start) / step
+	for couponDate(ref, n*step).After(start) {
+		n--
+	}
+	for !couponDate(ref, (n+1)*step).After(start) {
+		n++
+	}
+
+	sum := NewInt(0)
+	for from := start; from.Before(end); n++ {
+		periodStart, periodEnd := couponDate(ref, n*step), couponDate(ref, (n+1)*step)
+		to := periodEnd
+		if end.Before(to) {
+			to = end
+		}
+		days := NewInt(daysBetween(from, to))
+		sum = sum.Add(days.Div(NewInt(freq * daysBetween(periodStart, periodEnd))))
+		from = to
+	}
+	return sum
+}
+
+func thirty360(y1, y2, m1, m2, d1, d2 int) Decimal {
+	return NewInt((y2-y1)*360 + (m2-m1)*30 + d2 - d1).Div(NewInt(360))
+}
+
+// daysBetween counts calendar days from start to end, ignoring the time of day.
+func daysBetween(start, end time.Time) int {
+	return int(dayNumber(end) - dayNumber(start))
+}
+
+// dayNumber returns the number of days since the Unix epoch of the calendar date of t in its location.
+func dayNumber(t time.Time) int64 {
+	y, m, d := t.Date()
+	return date(y, m, d).Unix() / 86400
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func isLeap(y int) bool { return y%4 == 0 && (y%100 != 0 || y%400 == 0) }
+
+func daysIn(y int, m time.Month) int { return date(y, m+1, 0).Day() }
+
+func isLastOfFebruary(t time.Time) bool {
+	return t.Month() == time.February && t.Day() == daysIn(t.Year(), time.February)
+}
+
+// includesLeapDay returns true if 29 February falls in the period (start, end].
+func includesLeapDay(start, end time.Time) bool {
+	for y := start.Year(); y <= end.Year(); y++ {
+		if !isLeap(y) {
+			continue
+		}
+		leapDay := dayNumber(date(y, time.February, 29))
+		if dayNumber(start) < leapDay && leapDay <= dayNumber(end) {
+			return true
+		}
+	}
+	return false
+}
+
+// addMonths moves t by n months, clamping the day to the end of the resulting month.
+func addMonths(t time.Time, n int) time.Time {
+	y, m, d := t.Date()
+	months := int(m) - 1 + n
+	y, m = y+floorDiv(months, 12), time.Month(floorMod(months, 12)+1)
+	if max := daysIn(y, m); d > max {
+		d = max
+	}
+	return time.Date(y, m, d, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
+
+// couponDate is the date n months from ref, on the same day of the month as ref
+// or the end of the month if shorter.
+func couponDate(ref time.Time, n int) time.Time {
+	y, m, d := addMonths(ref, n).Date()
+	return date(y, m, d)
+}
+
+// couponFrequency returns the number of coupon periods a year, defaulting to 1, or 0 unless
+// it divides 12 so that every period is a whole number of months.
+func couponFrequency(freq int) int {
+	switch freq {
+	case 0:
+		return 1
+	case 1, 2, 3, 4, 6, 12:
+		return freq
+	}
+	return 0
+}
+
+// monthsBetween counts whole calendar months from start to end, ignoring the day.
+func monthsBetween(start, end time.Time) int {
+	return (end.Year()-start.Year())*12 + int(end.Month()) - int(start.Month())
+}
+
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+func floorMod(a, b int) int { return a - floorDiv(a, b)*b }
@@ -0,0 +1,123 @@
+package money
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func ExampleActualActualISDA() {
+	start, end := date(2003, time.November, 1), date(2004, time.May, 1)
+	fmt.Print(ActualActualISDA{}.YearFraction(start, end).RoundDP(12, ToNearestEven))
+	// Output: 0.497724380567
+}
+
+func ExampleActualActualICMA() {
+	start, end := date(2002, time.August, 15), date(2003, time.July, 15)
+	dc := ActualActualICMA{Frequency: 2, Reference: end}
+	fmt.Print(dc.YearFraction(start, end).RoundDP(12, ToNearestEven))
+	// Output: 0.915760869565
+}
+
+// Test cases from the ISDA paper "EMU and Market Conventions: Recent Developments".
+func TestActualActual(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		start, end time.Time
+		freq       int
+		ref        time.Time
+		isda, icma Decimal
+	}{
+		{"regular", date(2003, 11, 1), date(2004, 5, 1), 2, date(2004, 5, 1), NewScalar(497724380567, 12), half},
+		{"short first", date(1999, 2, 1), date(1999, 7, 1), 1, date(1999, 7, 1), NewScalar(410958904110, 12), NewScalar(410958904110, 12)},
+		{"long first", date(2002, 8, 15), date(2003, 7, 15), 2, date(2003, 7, 15), NewScalar(915068493151, 12), NewScalar(915760869565, 12)},
+		{"short final", date(1999, 7, 30), date(2000, 1, 30), 2, date(1999, 7, 30), NewScalar(503892506924, 12), half},
+		{"long final", date(2000, 1, 30), date(2000, 6, 30), 2, date(2000, 1, 30), NewScalar(415300546448, 12), NewScalar(417582417582, 12)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assertYearFraction(t, ActualActualISDA{}, tc.start, tc.end, tc.isda)
+			assertYearFraction(t, ActualActualICMA{tc.freq, tc.ref}, tc.start, tc.end, tc.icma)
+		})
+	}
+}
+
+func TestThirty360(t *testing.T) {
+	for _, tc := range []struct {
+		start, end    time.Time
+		us, eu, eplus int // days in a 360 day year
+	}{
+		{date(2007, 1, 15), date(2007, 1, 30), 15, 15, 15},
+		{date(2007, 1, 15), date(2007, 2, 15), 30, 30, 30},
+		{date(2007, 1, 15), date(2007, 7, 15), 180, 180, 180},
+		{date(2007, 9, 30), date(2008, 3, 31), 180, 180, 181},
+		{date(2007, 9, 30), date(2007, 10, 31), 30, 30, 31},
+		{date(2007, 9, 30), date(2008, 9, 30), 360, 360, 360},
+		{date(2007, 1, 15), date(2007, 1, 31), 16, 15, 16},
+		{date(2007, 1, 31), date(2007, 2, 28), 28, 28, 28},
+		{date(2007, 1, 31), date(2007, 3, 31), 60, 60, 61},
+		{date(2007, 2, 28), date(2007, 3, 31), 30, 32, 33},
+		{date(2007, 2, 28), date(2008, 2, 29), 360, 361, 361},
+		{date(2008, 2, 29), date(2009, 2, 28), 360, 359, 359},
+		{date(2006, 8, 31), date(2007, 2, 28), 178, 178, 178},
+		{date(2007, 2, 28), date(2007, 8, 31), 180, 182, 183},
+	} {
+		t.Run(fmt.Sprintf("%s-%s", tc.start.Format("2006-01-02"), tc.end.Format("2006-01-02")), func(t *testing.T) {
+			assertYearFraction(t, Thirty360US{}, tc.start, tc.end, NewInt(tc.us).Div(NewInt(360)))
+			assertYearFraction(t, Thirty360European{}, tc.start, tc.end, NewInt(tc.eu).Div(NewInt(360)))
+			assertYearFraction(t, Thirty360EPlus{}, tc.start, tc.end, NewInt(tc.eplus).Div(NewInt(360)))
+		})
+	}
+}
+
+func TestActual(t *testing.T) {
+	for _, tc := range []struct {
+		start, end        time.Time
+		a360, a365, a365l Decimal
+		freq              int
+	}{
+		{date(2007, 1, 1), date(2007, 7, 1), NewInt(181).Div(NewInt(360)), NewInt(181).Div(NewInt(365)), NewInt(181).Div(NewInt(365)), 2},
+		{date(2007, 7, 1), date(2008, 1, 1), NewInt(184).Div(NewInt(360)), NewInt(184).Div(NewInt(365)), NewInt(184).Div(NewInt(366)), 2},
+		{date(2007, 7, 1), date(2008, 7, 1), NewInt(366).Div(NewInt(360)), NewInt(366).Div(NewInt(365)), NewInt(1), 1},
+		{date(2008, 3, 1), date(2009, 3, 1), NewInt(365).Div(NewInt(360)), NewInt(1), NewInt(1), 1},
+		{date(2008, 2, 29), date(2009, 2, 28), NewInt(365).Div(NewInt(360)), NewInt(1), NewInt(1), 1},
+		{date(2007, 2, 28), date(2008, 2, 29), NewInt(366).Div(NewInt(360)), NewInt(366).Div(NewInt(365)), NewInt(1), 1},
+	} {
+		t.Run(fmt.Sprintf("%s-%s", tc.start.Format("2006-01-02"), tc.end.Format("2006-01-02")), func(t *testing.T) {
+			assertYearFraction(t, Actual360{}, tc.start, tc.end, tc.a360)
+			assertYearFraction(t, Actual365Fixed{}, tc.start, tc.end, tc.a365)
+			assertYearFraction(t, Actual365L{tc.freq}, tc.start, tc.end, tc.a365l)
+		})
+	}
+}
+
+func TestDayCountProperties(t *testing.T) {
+	start, end := date(2003, 11, 1), date(2006, 2, 28)
+	for _, dc := range []DayCount{
+		Thirty360US{}, Thirty360European{}, Thirty360EPlus{},
+		Actual360{}, Actual365Fixed{}, Actual365L{1}, Actual365L{4},
+		ActualActualISDA{}, ActualActualICMA{Frequency: 4}, ActualActualICMA{}, ActualActualICMA{Frequency: 12},
+	} {
+		t.Run(fmt.Sprintf("%T", dc), func(t *testing.T) {
+			if yf := dc.YearFraction(start, start); yf.value.Sign() != 0 {
+				t.Errorf("same day: wanted 0, got %v", yf)
+			}
+			forward, backward := dc.YearFraction(start, end), dc.YearFraction(end, start)
+			if !forward.Add(backward).EqualTo(NewInt(0), 20) {
+				t.Errorf("wanted %v to be the negation of %v", backward, forward)
+			}
+			local := time.FixedZone("UTC-10", -10*60*60)
+			late := time.Date(2003, 11, 1, 23, 0, 0, 0, local)
+			if yf := dc.YearFraction(late, time.Date(2006, 2, 28, 1, 0, 0, 0, local)); !yf.Equals(forward) {
+				t.Errorf("time of day: wanted %v, got %v", forward, yf)
+			}
+		})
+	}
+}
+
+func assertYearFraction(t *testing.T, dc DayCount, start, end time.Time, want Decimal) {
+	t.Helper()
+	got := dc.YearFraction(start, end)
+	if !got.RoundDP(12, ToNearestEven).Equals(want.RoundDP(12, ToNearestEven)) {
+		t.Errorf("%T: wanted %v, got %v", dc, want, got)
+	}
+}
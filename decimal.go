@@ -31,6 +31,11 @@ const (
 )
 
 // Decimal is an immutable, arbitrary precision decimal number.
+//
+// The zero value is treated as zero. As with float64, Equals, EqualTo, LessThan,
+// LessThanOrEqual, GreaterThan and GreaterThanOrEqual return false if either value is NaN,
+// as do IsZero, IsNegative and IsPositive. Cmp instead gives a total order in which NaN
+// sorts before all other values.
 type Decimal struct {
 	value dec
 }
@@ -60,8 +65,13 @@ func Bp(v int64) Decimal { return decr(v, 4) }
 // Verbs are the same as for the underlying decimal.Big, except %v and %d are the same as %f.
 // %c will multiply by 100, use %f and append '%'.
 // If a precision is requested for negative scale decimals, these are appended.
+// NaN and infinite values are written as with %s.
 func (d Decimal) Format(s fmt.State, c rune) {
-	if d.value == nil || d.value.Sign() == 0 {
+	if !d.val().IsFinite() {
+		d.value.Format(s, 's')
+		return
+	}
+	if d.IsZero() {
 		// Handle special-case zero, we don't want Go operating mode treatment.
 		d.value = new(eld.Big)
 	} else {
@@ -87,35 +97,95 @@ func (d Decimal) Format(s fmt.State, c rune) {
 
 // Equals returns true if the two numbers represent the same value.
 func (d Decimal) Equals(other Decimal) bool {
-	return !d.value.IsNaN(0) && !other.value.IsNaN(0) && d.value.Cmp(other.value) == 0
+	return d.comparable(other) && d.Cmp(other) == 0
 }
 
 // EqualTo returns true if two numbers are equal to the specified significant figures.
 func (d Decimal) EqualTo(other Decimal, sigfigs int) bool {
-	a := zero().Copy(d.value).Round(sigfigs)
-	b := zero().Copy(other.value).Round(sigfigs)
+	if !d.comparable(other) {
+		return false
+	}
+	a := zero().Copy(d.val()).Round(sigfigs)
+	b := zero().Copy(other.val()).Round(sigfigs)
 	return a.Sub(a, b).Sign() == 0
 }
 
 // LessThan than returns true if the receiver is less than the argument.
 func (d Decimal) LessThan(other Decimal) bool {
-	v, o := d.value, other.value
-	if v == nil {
-		v = zero()
+	return d.comparable(other) && d.Cmp(other) < 0
+}
+
+// LessThanOrEqual returns true if the receiver is less than or equal to the argument.
+func (d Decimal) LessThanOrEqual(other Decimal) bool {
+	return d.comparable(other) && d.Cmp(other) <= 0
+}
+
+// GreaterThan returns true if the receiver is greater than the argument.
+func (d Decimal) GreaterThan(other Decimal) bool {
+	return d.comparable(other) && d.Cmp(other) > 0
+}
+
+// GreaterThanOrEqual returns true if the receiver is greater than or equal to the argument.
+func (d Decimal) GreaterThanOrEqual(other Decimal) bool {
+	return d.comparable(other) && d.Cmp(other) >= 0
+}
+
+// Cmp compares the receiver to the argument, returning -1 if it is less, 0 if equal and +1 if greater.
+//
+// NaN is equal to NaN and less than any other value, so Cmp can be used for sorting.
+func (d Decimal) Cmp(other Decimal) int {
+	v, o := d.val(), other.val()
+	switch vn, on := v.IsNaN(0), o.IsNaN(0); {
+	case vn && on:
+		return 0
+	case vn:
+		return -1
+	case on:
+		return +1
 	}
-	if o == nil {
-		o = zero()
+	return v.Cmp(o)
+}
+
+// Sign returns -1 if the decimal is negative, 0 if it is zero or NaN and +1 if it is positive.
+func (d Decimal) Sign() int {
+	v := d.val()
+	if v.IsNaN(0) {
+		return 0
 	}
-	return !v.IsNaN(0) && !o.IsNaN(0) && v.Cmp(o) < 0
+	return v.Sign()
+}
+
+// IsZero returns true if the decimal is zero, including negative zero.
+func (d Decimal) IsZero() bool {
+	return !d.val().IsNaN(0) && d.Sign() == 0
+}
+
+// IsNegative returns true if the decimal is less than zero.
+func (d Decimal) IsNegative() bool { return d.Sign() < 0 }
+
+// IsPositive returns true if the decimal is greater than zero.
+func (d Decimal) IsPositive() bool { return d.Sign() > 0 }
+
+// comparable returns true if neither value is NaN.
+func (d Decimal) comparable(other Decimal) bool {
+	return !d.val().IsNaN(0) && !other.val().IsNaN(0)
 }
 
-func zero() dec {
-	z := new(eld.Big)
-	z.Context = eld.Context128
-	z.Context.OperatingMode = eld.Go
-	return z
+// orZero returns zero in place of the zero Decimal value.
+func orZero(d Decimal) Decimal { return wrap(d.val()) }
+
+// val returns the underlying value, or zero for the zero Decimal value.
+func (d Decimal) val() dec {
+	if d.value == nil {
+		return zero()
+	}
+	return d.value
 }
 
+func zero() dec { return defaultContext.zero() }
+
+func nan() Decimal { return wrap(zero().SetNaN(false)) }
+
 func wrap(value dec) Decimal              { return Decimal{value} }
 func dec2(value int64) Decimal            { return wrap(zero().SetMantScale(value, 2)) }
 func decr(value int64, scale int) Decimal { return wrap(zero().SetMantScale(value, scale).Reduce()) }
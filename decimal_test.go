@@ -59,6 +59,83 @@ func ExampleDecimal_LessThan() {
 	// Output: false
 }
 
+func ExampleDecimal_Cmp() {
+	fmt.Println(New(1).Cmp(NewCents(99)), New(1).Cmp(NewInt(1)), Decimal{}.Cmp(NewCents(1)))
+	// Output: 1 0 -1
+}
+
+func ExampleDecimal_Sign() {
+	fmt.Println(NewCents(-5).Sign(), Decimal{}.Sign(), Pc(5).Sign())
+	// Output: -1 0 1
+}
+
+func TestDecimal_Compare(t *testing.T) {
+	nan := wrap(zero().SetNaN(false))
+	for _, tc := range []struct {
+		a, b           Decimal
+		cmp            int
+		eq, lt, le, gt bool
+	}{
+		{New(1), NewCents(100), 0, true, false, true, false},
+		{New(1), NewCents(101), -1, false, true, true, false},
+		{New(2), NewCents(101), 1, false, false, false, true},
+		{NewCents(-1), Decimal{}, -1, false, true, true, false},
+		{Decimal{}, NewInt(0), 0, true, false, true, false},
+		{Decimal{}, Decimal{}, 0, true, false, true, false},
+		{Decimal{}, Pc(1), -1, false, true, true, false},
+		{wrap(zero().SetMantScale(0, 2).Neg(zero())), NewInt(0), 0, true, false, true, false},
+		{nan, NewInt(1), -1, false, false, false, false},
+		{NewInt(1), nan, 1, false, false, false, false},
+		{nan, nan, 0, false, false, false, false},
+		{nan, Decimal{}, -1, false, false, false, false},
+	} {
+		a, b := tc.a, tc.b
+		if got := a.Cmp(b); got != tc.cmp {
+			t.Errorf("%v cmp %v: wanted %d, got %d", a, b, tc.cmp, got)
+		}
+		if got := a.Equals(b); got != tc.eq {
+			t.Errorf("%v equals %v: wanted %t, got %t", a, b, tc.eq, got)
+		}
+		if got := a.EqualTo(b, 5); got != tc.eq {
+			t.Errorf("%v equal to %v: wanted %t, got %t", a, b, tc.eq, got)
+		}
+		if got := a.LessThan(b); got != tc.lt {
+			t.Errorf("%v less than %v: wanted %t, got %t", a, b, tc.lt, got)
+		}
+		if got := a.LessThanOrEqual(b); got != tc.le {
+			t.Errorf("%v less than or equal %v: wanted %t, got %t", a, b, tc.le, got)
+		}
+		if got := a.GreaterThan(b); got != tc.gt {
+			t.Errorf("%v greater than %v: wanted %t, got %t", a, b, tc.gt, got)
+		}
+		if got, want := a.GreaterThanOrEqual(b), tc.gt || tc.eq; got != want {
+			t.Errorf("%v greater than or equal %v: wanted %t, got %t", a, b, want, got)
+		}
+	}
+}
+
+func TestDecimal_Sign(t *testing.T) {
+	nan := wrap(zero().SetNaN(false))
+	for _, tc := range []struct {
+		d              Decimal
+		sign           int
+		zero, neg, pos bool
+	}{
+		{NewCents(-1), -1, false, true, false},
+		{NewCents(1), 1, false, false, true},
+		{NewCents(0), 0, true, false, false},
+		{Decimal{}, 0, true, false, false},
+		{wrap(zero().Neg(zero())), 0, true, false, false},
+		{nan, 0, false, false, false},
+	} {
+		d := tc.d
+		if d.Sign() != tc.sign || d.IsZero() != tc.zero || d.IsNegative() != tc.neg || d.IsPositive() != tc.pos {
+			t.Errorf("%v: wanted %d %t %t %t, got %d %t %t %t", d, tc.sign, tc.zero, tc.neg, tc.pos,
+				d.Sign(), d.IsZero(), d.IsNegative(), d.IsPositive())
+		}
+	}
+}
+
 func ExampleDecimal_Format() {
 	print := func(format string, d Decimal) {
 		fmt.Printf(format+"\n", d)
@@ -110,6 +187,9 @@ func TestDecimalFormat(t *testing.T) {
 		{"%.2c", Decimal{}, "0.00%"},
 		{"%10.2c", decr(1, 0), "    100.00%"},
 		{"%.2c", decr(101, -1), "101000.00%"},
+		{"%.2f", wrap(zero().SetNaN(false)), "NaN"},
+		{"%c", wrap(zero().SetNaN(false)), "NaN"},
+		{"%v", wrap(zero().SetInf(true)), "-Inf"},
 	} {
 		got := fmt.Sprintf(tc.fs, tc.d)
 		if got != tc.want {
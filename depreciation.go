@@ -0,0 +1,236 @@
+package money
+
+import "time"
+
+// Asset describes an asset to be depreciated over a number of years.
+//
+// Depreciation schedules return an amount for each year the asset is in service. If the first year
+// is partial, as determined by Convention, the schedule has one more year than Life, ending with the
+// remainder of the final year. Depreciation never takes the book value below Salvage.
+//
+// Amounts are rounded so that the cumulative depreciation rounds exactly, so the schedule always
+// sums to the rounded total depreciation.
+//
+// StraightLine, SumOfYearsDigits, DecliningBalance and DoubleDecliningBalance return nil unless
+// Life is positive and, for the FullMonth and MidMonth conventions, Month is set.
+type Asset struct {
+	Cost    Decimal
+	Salvage Decimal // value at the end of its useful life
+	Life    int     // useful life in years, or the recovery period for MACRS
+
+	Convention FirstYearConvention // how much of the first year the asset is in service
+	Month      time.Month          // month placed in service, for FullMonth and MidMonth conventions
+
+	DP       int          // decimal places each year is rounded to, e.g. 2 for cents
+	Rounding RoundingMode // rounding mode used for each year
+}
+
+// FirstYearConvention determines the fraction of the first year an asset is depreciated for.
+type FirstYearConvention int
+
+// First year conventions.
+const (
+	// FullYear depreciates the first year in full, regardless of when the asset was placed in service.
+	FullYear FirstYearConvention = iota
+	// FullMonth depreciates whole months, including the month placed in service.
+	FullMonth
+	// MidMonth depreciates half of the month placed in service and then whole months.
+	MidMonth
+	// HalfYear depreciates half of the first year.
+	HalfYear
+)
+
+// StraightLine depreciates the asset by the same amount each year, i.e. (Cost - Salvage) / Life.
+func (a Asset) StraightLine() []Decimal {
+	if !a.valid() {
+		return nil
+	}
+	annual := a.depreciable().Div(NewInt(a.Life))
+	years := make([]Decimal, a.Life)
+	for i := range years {
+		years[i] = annual
+	}
+	return a.prorate(years)
+}
+
+// SumOfYearsDigits depreciates the asset by (Cost - Salvage) * (Life - i) / (1 + 2 + ... + Life) in year i,
+// counting from zero.
+func (a Asset) SumOfYearsDigits() []Decimal {
+	if !a.valid() {
+		return nil
+	}
+	digits := NewInt(a.Life * (a.Life + 1) / 2)
+	years := make([]Decimal, a.Life)
+	for i := range years {
+		years[i] = a.depreciable().Mul(NewInt(a.Life - i)).Div(digits)
+	}
+	return a.prorate(years)
+}
+
+// DecliningBalance depreciates the book value by a fixed rate of factor / Life each year
+// e.g. a factor of 1.5 for 150% declining balance.
+//
+// The asset is not fully depreciated by the end of its life, unless limited by Salvage.
+func (a Asset) DecliningBalance(factor Decimal) []Decimal {
+	return a.declining(factor, false)
+}
+
+// DoubleDecliningBalance depreciates the book value by a fixed rate of 2 / Life each year,
+// switching to straight line over the remaining life when that gives a larger amount.
+//
+// The asset is fully depreciated to Salvage by the end of its life.
+func (a Asset) DoubleDecliningBalance() []Decimal {
+	return a.declining(NewInt(2), true)
+}
+
+// UnitsOfProduction depreciates the asset by (Cost - Salvage) * units[i] / totalUnits in year i.
+//
+// The first year convention does not apply. UnitsOfProduction returns nil unless totalUnits
+// is positive and no year has negative units.
+func (a Asset) UnitsOfProduction(totalUnits Decimal, units []Decimal) []Decimal {
+	if !totalUnits.IsPositive() {
+		return nil
+	}
+	for _, u := range units {
+		if u.IsNegative() {
+			return nil
+		}
+	}
+	remaining := a.depreciable()
+	years := make([]Decimal, len(units))
+	for i, u := range units {
+		years[i] = Min(a.depreciable().Mul(u).Div(totalUnits), remaining)
+		remaining = remaining.Sub(years[i])
+	}
+	return a.round(years)
+}
+
+// MACRS depreciates the asset using the US Modified Accelerated Cost Recovery System
+// General Depreciation System tables, for a recovery period (Life) of 3, 5, 7, 10, 15 or 20 years.
+//
+// The tables assume the half-year convention and ignore Salvage, so Convention and Salvage
+// do not apply. MACRS returns nil for any other recovery period.
+func (a Asset) MACRS() []Decimal {
+	table, ok := macrsHalfYear[a.Life]
+	if !ok {
+		return nil
+	}
+	years := make([]Decimal, len(table))
+	for i, pc := range table {
+		years[i] = a.Cost.Mul(NewScalar(pc, 5))
+	}
+	return a.round(years)
+}
+
+// macrsHalfYear are the rates from IRS Publication 946 Table A-1, in thousandths of a percent.
+var macrsHalfYear = map[int][]int64{
+	3:  {33330, 44450, 14810, 7410},
+	5:  {20000, 32000, 19200, 11520, 11520, 5760},
+	7:  {14290, 24490, 17490, 12490, 8930, 8920, 8930, 4460},
+	10: {10000, 18000, 14400, 11520, 9220, 7370, 6550, 6550, 6560, 6550, 3280},
+	15: {5000, 9500, 8550, 7700, 6930, 6230, 5900, 5900, 5910, 5900, 5910, 5900, 5910, 5900, 5910, 2950},
+	20: {3750, 7219, 6677, 6177, 5713, 5285, 4888, 4522, 4462, 4461, 4462, 4461, 4462, 4461, 4462, 4461, 4462, 4461, 4462, 4461, 2231},
+}
+
+// declining calculates declining balance depreciation for each year in service,
+// optionally switching to straight line.
+func (a Asset) declining(factor Decimal, switchToStraightLine bool) []Decimal {
+	if !a.valid() {
+		return nil
+	}
+	rate := factor.Div(NewInt(a.Life))
+	fractions := a.fractions()
+	book, elapsed := a.Cost, NewInt(0)
+
+	years := make([]Decimal, len(fractions))
+	for i, f := range fractions {
+		dep := book.Mul(rate).Mul(f)
+		if switchToStraightLine {
+			remaining := NewInt(a.Life).Sub(elapsed)
+			dep = Max(dep, book.Sub(a.salvage()).Mul(f).Div(remaining))
+		}
+		dep = Min(dep, book.Sub(a.salvage()))
+		years[i] = dep
+		book = book.Sub(dep)
+		elapsed = elapsed.Add(f)
+	}
+	return a.round(years)
+}
+
+// prorate converts depreciation for each year of the asset's life into each year in service,
+// when the first year is partial.
+func (a Asset) prorate(lifeYears []Decimal) []Decimal {
+	p := a.firstYear()
+	if p.Equals(NewInt(1)) {
+		return a.round(lifeYears)
+	}
+	q := NewInt(1).Sub(p)
+	years := make([]Decimal, len(lifeYears)+1)
+	years[0] = lifeYears[0].Mul(p)
+	for i := 1; i < len(lifeYears); i++ {
+		years[i] = lifeYears[i-1].Mul(q).Add(lifeYears[i].Mul(p))
+	}
+	years[len(lifeYears)] = lifeYears[len(lifeYears)-1].Mul(q)
+	return a.round(years)
+}
+
+// fractions returns the fraction of each year in service.
+func (a Asset) fractions() []Decimal {
+	p := a.firstYear()
+	if p.Equals(NewInt(1)) {
+		fractions := make([]Decimal, a.Life)
+		for i := range fractions {
+			fractions[i] = NewInt(1)
+		}
+		return fractions
+	}
+	fractions := make([]Decimal, a.Life+1)
+	fractions[0] = p
+	for i := 1; i < a.Life; i++ {
+		fractions[i] = NewInt(1)
+	}
+	fractions[a.Life] = NewInt(1).Sub(p)
+	return fractions
+}
+
+// firstYear returns the fraction of the first year in service.
+func (a Asset) firstYear() Decimal {
+	switch a.Convention {
+	case FullMonth:
+		return NewInt(13 - int(a.Month)).Div(NewInt(12))
+	case MidMonth:
+		return NewScalar(int64(125-10*int(a.Month)), 1).Div(NewInt(12))
+	case HalfYear:
+		return half
+	default:
+		return NewInt(1)
+	}
+}
+
+// valid returns true if the asset has a positive life and a month placed in service when required.
+func (a Asset) valid() bool {
+	switch a.Convention {
+	case FullMonth, MidMonth:
+		if a.Month < time.January || a.Month > time.December {
+			return false
+		}
+	}
+	return a.Life > 0
+}
+
+// round rounds the cumulative depreciation, so that rounding errors do not accumulate.
+func (a Asset) round(years []Decimal) []Decimal {
+	rounded := make([]Decimal, len(years))
+	cumulative, last := NewInt(0), NewInt(0)
+	for i, y := range years {
+		cumulative = cumulative.Add(y)
+		next := quantize(cumulative, a.DP, a.Rounding)
+		rounded[i] = next.Sub(last)
+		last = next
+	}
+	return rounded
+}
+
+func (a Asset) salvage() Decimal { return orZero(a.Salvage) }
+
+func (a Asset) depreciable() Decimal { return a.Cost.Sub(a.salvage()) }
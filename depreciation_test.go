@@ -0,0 +1,133 @@
+package money
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func ExampleAsset_DoubleDecliningBalance() {
+	a := Asset{Cost: New(1000), Life: 5, DP: 2}
+	fmt.Printf("%.2f", a.DoubleDecliningBalance())
+	// Output: [400.00 240.00 144.00 108.00 108.00]
+}
+
+func ExampleAsset_StraightLine() {
+	a := Asset{Cost: New(10000), Salvage: New(1000), Life: 5, Convention: HalfYear, DP: 2}
+	fmt.Printf("%.2f", a.StraightLine())
+	// Output: [900.00 1800.00 1800.00 1800.00 1800.00 900.00]
+}
+
+func TestAsset_Depreciation(t *testing.T) {
+	asset := Asset{Cost: New(10000), Salvage: New(1000), Life: 5, DP: 2, Rounding: ToNearestEven}
+	with := func(c FirstYearConvention, m time.Month) Asset {
+		a := asset
+		a.Convention, a.Month = c, m
+		return a
+	}
+	for _, tc := range []struct {
+		name string
+		got  []Decimal
+		want string
+	}{
+		{"straight line", asset.StraightLine(), "[1800.00 1800.00 1800.00 1800.00 1800.00]"},
+		{"straight line full month", with(FullMonth, time.October).StraightLine(), "[450.00 1800.00 1800.00 1800.00 1800.00 1350.00]"},
+		{"straight line mid month", with(MidMonth, time.October).StraightLine(), "[375.00 1800.00 1800.00 1800.00 1800.00 1425.00]"},
+		{"straight line january", with(FullMonth, time.January).StraightLine(), "[1800.00 1800.00 1800.00 1800.00 1800.00]"},
+		{"sum of years digits", asset.SumOfYearsDigits(), "[3000.00 2400.00 1800.00 1200.00 600.00]"},
+		{"sum of years digits half year", with(HalfYear, 0).SumOfYearsDigits(), "[1500.00 2700.00 2100.00 1500.00 900.00 300.00]"},
+		{"declining balance 150%", asset.DecliningBalance(NewScalar(15, 1)), "[3000.00 2100.00 1470.00 1029.00 720.30]"},
+		{"double declining balance", asset.DoubleDecliningBalance(), "[4000.00 2400.00 1440.00 864.00 296.00]"},
+		{"double declining balance half year", with(HalfYear, 0).DoubleDecliningBalance(), "[2000.00 3200.00 1920.00 1152.00 691.20 36.80]"},
+		{"double declining balance no salvage", Asset{Cost: New(1000), Life: 5, DP: 2}.DoubleDecliningBalance(), "[400.00 240.00 144.00 108.00 108.00]"},
+		{"double declining balance whole units", Asset{Cost: New(1000), Life: 3, DP: 0}.DoubleDecliningBalance(), "[667.00 222.00 111.00]"},
+		{"units of production", asset.UnitsOfProduction(NewInt(1000), []Decimal{NewInt(200), NewInt(300), NewInt(600), NewInt(100)}), "[1800.00 2700.00 4500.00 0.00]"},
+		{"units of production thirds", Asset{Cost: New(100), DP: 2}.UnitsOfProduction(NewInt(3), []Decimal{NewInt(1), NewInt(1), NewInt(1)}), "[33.33 33.34 33.33]"},
+		{"macrs 5 year", Asset{Cost: New(10000), Life: 5, DP: 2}.MACRS(), "[2000.00 3200.00 1920.00 1152.00 1152.00 576.00]"},
+		{"macrs 7 year", Asset{Cost: New(10000), Life: 7, DP: 2}.MACRS(), "[1429.00 2449.00 1749.00 1249.00 893.00 892.00 893.00 446.00]"},
+		{"macrs unknown", Asset{Cost: New(10000), Life: 4, DP: 2}.MACRS(), "[]"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if s := fmt.Sprintf("%.2f", tc.got); s != tc.want {
+				t.Errorf("wanted %s, got %s", tc.want, s)
+			}
+		})
+	}
+}
+
+func TestMACRSTables(t *testing.T) {
+	for life, table := range macrsHalfYear {
+		if len(table) != life+1 {
+			t.Errorf("%d year: wanted %d rates, got %d", life, life+1, len(table))
+		}
+		var sum int64
+		for _, pc := range table {
+			sum += pc
+		}
+		if sum != 100000 {
+			t.Errorf("%d year: rates sum to %d", life, sum)
+		}
+	}
+}
+
+func TestAsset_Totals(t *testing.T) {
+	for _, c := range []FirstYearConvention{FullYear, FullMonth, MidMonth, HalfYear} {
+		for _, m := range []time.Month{time.January, time.June, time.December} {
+			a := Asset{Cost: NewCents(1234567), Salvage: NewCents(10001), Life: 7, Convention: c, Month: m, DP: 2}
+			for name, schedule := range map[string][]Decimal{
+				"straight line":    a.StraightLine(),
+				"sum of years":     a.SumOfYearsDigits(),
+				"double declining": a.DoubleDecliningBalance(),
+			} {
+				sum := NewInt(0)
+				for _, d := range schedule {
+					if d.value.Signbit() {
+						t.Errorf("%s %d %v: negative depreciation %v", name, c, m, schedule)
+					}
+					sum = sum.Add(d)
+				}
+				if !sum.Equals(a.Cost.Sub(a.Salvage)) {
+					t.Errorf("%s %d %v: total %v, wanted %v", name, c, m, sum, a.Cost.Sub(a.Salvage))
+				}
+			}
+		}
+	}
+}
+
+func TestAsset_Invalid(t *testing.T) {
+	for _, a := range []Asset{
+		{Cost: New(1000), Life: 0, Convention: HalfYear, DP: 2},
+		{Cost: New(1000), Life: -1, DP: 2},
+		{Cost: New(1000), Life: 3, Convention: FullMonth, DP: 2},
+		{Cost: New(1000), Life: 3, Convention: MidMonth, Month: 13, DP: 2},
+	} {
+		for name, years := range map[string][]Decimal{
+			"straight line":            a.StraightLine(),
+			"sum of years digits":      a.SumOfYearsDigits(),
+			"declining balance":        a.DecliningBalance(NewScalar(15, 1)),
+			"double declining balance": a.DoubleDecliningBalance(),
+		} {
+			if years != nil {
+				t.Errorf("%s %+v: wanted nil, got %v", name, a, years)
+			}
+		}
+	}
+}
+
+func TestAsset_UnitsOfProduction_Invalid(t *testing.T) {
+	a := Asset{Cost: New(1000), Salvage: New(100), Life: 3, DP: 2}
+	for _, tc := range []struct {
+		total Decimal
+		units []Decimal
+	}{
+		{NewInt(0), []Decimal{NewInt(0), NewInt(0)}},
+		{NewInt(0), []Decimal{NewInt(10)}},
+		{NewInt(-100), []Decimal{NewInt(-10), NewInt(-20)}},
+		{Decimal{}, []Decimal{NewInt(10)}},
+		{NewInt(100), []Decimal{NewInt(10), NewInt(-20)}},
+	} {
+		if years := a.UnitsOfProduction(tc.total, tc.units); years != nil {
+			t.Errorf("%v of %v: wanted nil, got %v", tc.units, tc.total, years)
+		}
+	}
+}
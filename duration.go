@@ -0,0 +1,106 @@
+package money
+
+import "time"
+
+// YieldSensitivity measures the interest rate risk of dated cash flows at a flat yield.
+//
+// Each flow after settlement is discounted by (1 + yield/Frequency)^(Frequency*t), where t is
+// the year fraction from settlement to the flow. The flows can be any schedule, such as the
+// coupons of a bond, the payments of a loan or a set of liabilities.
+type YieldSensitivity struct {
+	Flows      []CashFlow
+	Settlement time.Time
+	Frequency  int      // compounding periods per year, defaulting to 1
+	DayCount   DayCount // defaulting to Actual/365 Fixed
+}
+
+// Sensitivity returns the cash flows of a bond after settlement, compounded with the coupon frequency.
+//
+// The price of the result is the dirty price of the bond.
+func (b Bond) Sensitivity(settlement time.Time) YieldSensitivity {
+	return YieldSensitivity{
+		Flows:      b.CashFlows(settlement),
+		Settlement: settlement,
+		Frequency:  b.frequency(),
+		DayCount:   b.dayCount(),
+	}
+}
+
+// Price calculates the present value of the flows, i.e. Σ flow[i] / (1 + yield/Frequency)^(Frequency*t[i]).
+func (s YieldSensitivity) Price(yield Decimal) Decimal {
+	price, _, _ := s.moments(yield)
+	return price
+}
+
+// MacaulayDuration calculates the present value weighted average time to the flows, in years.
+//
+// This is Σ t[i] * PV(flow[i]) / Price.
+func (s YieldSensitivity) MacaulayDuration(yield Decimal) Decimal {
+	price, first, _ := s.moments(yield)
+	return first.Div(price)
+}
+
+// ModifiedDuration calculates the relative change in price for a change in yield.
+//
+// This is MacaulayDuration / (1 + yield/Frequency).
+func (s YieldSensitivity) ModifiedDuration(yield Decimal) Decimal {
+	return s.MacaulayDuration(yield).Div(s.factor(yield))
+}
+
+// EffectiveDuration approximates the modified duration by shifting the yield up and down.
+//
+// This is [ Price(yield - shift) - Price(yield + shift) ] / (2 * Price(yield) * shift).
+func (s YieldSensitivity) EffectiveDuration(yield, shift Decimal) Decimal {
+	down, up := s.Price(yield.Sub(shift)), s.Price(yield.Add(shift))
+	return down.Sub(up).Div(s.Price(yield).Mul(shift).Mul(NewInt(2)))
+}
+
+// Convexity calculates the relative change in modified duration for a change in yield.
+//
+// This is Σ t[i] * (t[i] + 1/Frequency) * PV(flow[i]) / [ Price * (1 + yield/Frequency)^2 ].
+func (s YieldSensitivity) Convexity(yield Decimal) Decimal {
+	price, first, second := s.moments(yield)
+	m := NewInt(s.frequency())
+	return second.Add(first.Div(m)).Div(price.Mul(s.factor(yield).PowInt(2)))
+}
+
+// DV01 calculates the change in price for a one basis point fall in yield (also known as PV01).
+//
+// This is [ Price(yield - 0.0001) - Price(yield + 0.0001) ] / 2.
+func (s YieldSensitivity) DV01(yield Decimal) Decimal {
+	return s.Price(yield.Sub(Bp(1))).Sub(s.Price(yield.Add(Bp(1)))).Mul(half)
+}
+
+// moments calculates Σ PV(flow[i]), Σ t[i] * PV(flow[i]) and Σ t[i]^2 * PV(flow[i]).
+func (s YieldSensitivity) moments(yield Decimal) (Decimal, Decimal, Decimal) {
+	dc := s.DayCount
+	if dc == nil {
+		dc = Actual365Fixed{}
+	}
+	m := NewInt(s.frequency())
+	factor := s.factor(yield)
+
+	price, first, second := NewInt(0), NewInt(0), NewInt(0)
+	for _, f := range s.Flows {
+		if f.Date.Before(s.Settlement) {
+			continue
+		}
+		t := dc.YearFraction(s.Settlement, f.Date)
+		pv := f.Amount.Div(factor.Pow(t.Mul(m)))
+		price = price.Add(pv)
+		first = first.Add(pv.Mul(t))
+		second = second.Add(pv.Mul(t).Mul(t))
+	}
+	return price, first, second
+}
+
+func (s YieldSensitivity) factor(yield Decimal) Decimal {
+	return yield.Div(NewInt(s.frequency())).AddInt(1)
+}
+
+func (s YieldSensitivity) frequency() int {
+	if s.Frequency < 1 {
+		return 1
+	}
+	return s.Frequency
+}
@@ -0,0 +1,105 @@
+package money
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+// A 3 year 10% annual coupon bond.
+var threeYear = YieldSensitivity{
+	Flows: []CashFlow{
+		{date(2021, time.January, 1), New(10)},
+		{date(2022, time.January, 1), New(10)},
+		{date(2023, time.January, 1), New(110)},
+	},
+	Settlement: date(2020, time.January, 1),
+	Frequency:  1,
+	DayCount:   Thirty360European{},
+}
+
+func ExampleYieldSensitivity() {
+	yield := Pc(10)
+	fmt.Println(threeYear.Price(yield).RoundDP(4, ToNearestEven))
+	fmt.Println(threeYear.MacaulayDuration(yield).RoundDP(4, ToNearestEven))
+	fmt.Println(threeYear.ModifiedDuration(yield).RoundDP(4, ToNearestEven))
+	fmt.Println(threeYear.Convexity(yield).RoundDP(4, ToNearestEven))
+	fmt.Println(threeYear.DV01(yield).RoundDP(6, ToNearestEven))
+	// Output:
+	// 100.0000
+	// 2.7355
+	// 2.4869
+	// 8.7562
+	// 0.024869
+}
+
+func TestYieldSensitivity(t *testing.T) {
+	for _, tc := range []struct {
+		name                    string
+		s                       YieldSensitivity
+		yield                   Decimal
+		price, macaulay, convex Decimal
+	}{
+		{"3y annual", threeYear, Pc(10), New(100), NewScalar(27355, 4), NewScalar(87562, 4)},
+		{"3y annual at 5%", threeYear, Pc(5), NewScalar(1136162, 4), NewScalar(27525, 4), NewScalar(96896, 4)},
+		{
+			"zero coupon",
+			YieldSensitivity{Flows: []CashFlow{{date(2030, 1, 1), New(100)}}, Settlement: date(2020, 1, 1), DayCount: Thirty360European{}},
+			Pc(5), NewScalar(613913, 4), NewInt(10), NewScalar(997732, 4),
+		},
+		{
+			"semi-annual",
+			YieldSensitivity{
+				Flows: []CashFlow{
+					{date(2020, 7, 1), New(3)},
+					{date(2021, 1, 1), New(3)},
+					{date(2021, 7, 1), New(3)},
+					{date(2022, 1, 1), New(103)},
+				},
+				Settlement: date(2020, 1, 1),
+				Frequency:  2,
+				DayCount:   Thirty360European{},
+			},
+			Pc(6), New(100), NewScalar(19143, 4), NewScalar(44444, 4),
+		},
+		{
+			"ignores past flows",
+			YieldSensitivity{Flows: append([]CashFlow{{date(2019, 1, 1), New(1000)}}, threeYear.Flows...), Settlement: threeYear.Settlement, DayCount: Thirty360European{}},
+			Pc(10), New(100), NewScalar(27355, 4), NewScalar(87562, 4),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			price := tc.s.Price(tc.yield)
+			if got := price.RoundDP(4, ToNearestEven); !got.Equals(tc.price) {
+				t.Errorf("price: wanted %v, got %v", tc.price, got)
+			}
+			macaulay := tc.s.MacaulayDuration(tc.yield)
+			if got := macaulay.RoundDP(4, ToNearestEven); !got.Equals(tc.macaulay) {
+				t.Errorf("macaulay: wanted %v, got %v", tc.macaulay, got)
+			}
+			if got := tc.s.Convexity(tc.yield).RoundDP(4, ToNearestEven); !got.Equals(tc.convex) {
+				t.Errorf("convexity: wanted %v, got %v", tc.convex, got)
+			}
+
+			modified := tc.s.ModifiedDuration(tc.yield)
+			if want := macaulay.Div(tc.s.factor(tc.yield)); !modified.Equals(want) {
+				t.Errorf("modified: wanted %v, got %v", want, modified)
+			}
+			if got := tc.s.EffectiveDuration(tc.yield, Bp(1)); !got.EqualTo(modified, 5) {
+				t.Errorf("effective: wanted %v, got %v", modified, got)
+			}
+			if got, want := tc.s.DV01(tc.yield), modified.Mul(price).Mul(Bp(1)); !got.EqualTo(want, 5) {
+				t.Errorf("dv01: wanted %v, got %v", want, got)
+			}
+		})
+	}
+}
+
+func TestBond_Sensitivity(t *testing.T) {
+	b := Bond{Face: New(100), CouponRate: Pc(4), Frequency: 2, Issue: date(2020, 1, 15), Maturity: date(2025, 1, 15)}
+	settlement := date(2023, 10, 15)
+	s := b.Sensitivity(settlement)
+	if price, dirty := s.Price(Pc(5)), b.DirtyPrice(settlement, Pc(5)); !price.EqualTo(dirty, 20) {
+		t.Errorf("wanted price %v, got %v", dirty, price)
+	}
+}
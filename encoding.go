@@ -0,0 +1,139 @@
+package money
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	eld "github.com/ericlagergren/decimal"
+)
+
+// ErrNotFinite is returned when encoding or decoding a NaN or infinite value.
+var ErrNotFinite = errors.New("money: decimal is not finite")
+
+// binaryVersion is the first byte of the binary encoding.
+const binaryVersion byte = 1
+
+// MarshalText implements the encoding.TextMarshaler interface.
+//
+// The scale is preserved, so NewCents(100) is encoded as "1.00".
+func (d Decimal) MarshalText() ([]byte, error) {
+	if d.value == nil {
+		return []byte("0"), nil
+	}
+	if !d.value.IsFinite() {
+		return nil, ErrNotFinite
+	}
+	return []byte(d.value.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+//
+// The text is parsed with Parse.
+func (d *Decimal) UnmarshalText(text []byte) error {
+	v, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+//
+// The value is a bare number, e.g. 1.00. Use StringDecimal for a quoted string.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return d.MarshalText()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+//
+// Both numbers and quoted strings are accepted. A JSON null leaves the decimal unchanged.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		data = data[1 : len(data)-1]
+	}
+	return d.UnmarshalText(data)
+}
+
+// StringDecimal is a decimal marshalled to JSON as a quoted string, e.g. "1.00",
+// rather than a bare number, e.g. 1.00, for individual fields:
+//
+//	type Invoice struct {
+//		Total money.StringDecimal `json:"total"`
+//	}
+//
+// Quoted strings are safer for consumers that decode numbers to float64.
+// Either form is accepted when unmarshalling.
+type StringDecimal struct {
+	Decimal
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (d StringDecimal) MarshalJSON() ([]byte, error) {
+	text, err := d.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(`"` + string(text) + `"`), nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+//
+// The encoding is a version byte, the scale as a varint and then the gob encoding
+// of the unscaled value.
+func (d Decimal) MarshalBinary() ([]byte, error) {
+	if d.value == nil {
+		d = wrap(zero())
+	}
+	if !d.value.IsFinite() {
+		return nil, ErrNotFinite
+	}
+
+	mant, err := mantissa(d.value).GobEncode()
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, 1+binary.MaxVarintLen64, 1+binary.MaxVarintLen64+len(mant))
+	buf[0] = binaryVersion
+	n := binary.PutVarint(buf[1:], int64(d.value.Scale()))
+	return append(buf[:1+n], mant...), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (d *Decimal) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 || data[0] != binaryVersion {
+		return errors.New("money: unsupported binary encoding")
+	}
+
+	r := bytes.NewReader(data[1:])
+	scale, err := binary.ReadVarint(r)
+	if err == nil && (scale > eld.MaxScale || scale < eld.MinScale) {
+		err = errors.New("out of range")
+	}
+	if err != nil {
+		return fmt.Errorf("money: invalid binary scale: %v", err)
+	}
+
+	mant := new(big.Int)
+	if r.Len() == 0 {
+		return errors.New("money: missing binary mantissa")
+	}
+	if err := mant.GobDecode(data[len(data)-r.Len():]); err != nil {
+		return fmt.Errorf("money: invalid binary mantissa: %v", err)
+	}
+
+	*d = wrap(zero().SetBigMantScale(mant, int(scale)))
+	return nil
+}
+
+// mantissa returns the unscaled value of a finite decimal.
+func mantissa(v dec) *big.Int {
+	return zero().Copy(v).SetScale(0).Int(new(big.Int))
+}
@@ -0,0 +1,218 @@
+package money
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"testing"
+)
+
+func ExampleDecimal_MarshalJSON() {
+	type Invoice struct {
+		Total Decimal `json:"total"`
+	}
+
+	b, _ := json.Marshal(Invoice{NewCents(100)})
+	fmt.Println(string(b))
+	// Output: {"total":1.00}
+}
+
+func ExampleStringDecimal() {
+	type Invoice struct {
+		Total StringDecimal `json:"total"`
+		Tax   Decimal       `json:"tax"`
+	}
+
+	b, _ := json.Marshal(Invoice{StringDecimal{NewCents(120)}, NewCents(20)})
+	fmt.Println(string(b))
+
+	var inv Invoice
+	err := json.Unmarshal([]byte(`{"total": 2.40, "tax": "0.40"}`), &inv)
+	fmt.Println(inv.Total, inv.Tax, err)
+
+	// Output:
+	// {"total":"1.20","tax":0.20}
+	// 2.40 0.40 <nil>
+}
+
+func ExampleDecimal_UnmarshalJSON() {
+	var v struct{ A, B Decimal }
+	err := json.Unmarshal([]byte(`{"A": 12.50, "B": "0.125"}`), &v)
+	fmt.Println(v.A, v.B, err)
+	// Output: 12.50 0.125 <nil>
+}
+
+var encodingCases = []Decimal{
+	Decimal{},
+	NewCents(100),
+	NewCents(-1234),
+	New(0),
+	NewScalar(2345, -6),
+	NewScalar(3, 9),
+	Pc(2),
+	bigDecimal("-12345678901234567890123456789012", 2),
+}
+
+func TestDecimal_MarshalText(t *testing.T) {
+	for _, d := range encodingCases {
+		t.Run(fmt.Sprint(d), func(t *testing.T) {
+			text, err := d.MarshalText()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got Decimal
+			if err := got.UnmarshalText(text); err != nil {
+				t.Fatal(err)
+			}
+			assertIdentical(t, d, got)
+		})
+	}
+}
+
+func TestDecimal_MarshalJSON(t *testing.T) {
+	for _, quoted := range []bool{false, true} {
+		for _, d := range encodingCases {
+			t.Run(fmt.Sprintf("%v quoted=%t", d, quoted), func(t *testing.T) {
+				var v interface{} = d
+				if quoted {
+					v = StringDecimal{d}
+				}
+				b, err := json.Marshal(v)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if quoted != (b[0] == '"') {
+					t.Errorf("unexpected quoting: %s", b)
+				}
+				var got Decimal
+				if err := json.Unmarshal(b, &got); err != nil {
+					t.Fatal(err)
+				}
+				assertIdentical(t, d, got)
+
+				var str StringDecimal
+				if err := json.Unmarshal(b, &str); err != nil {
+					t.Fatal(err)
+				}
+				assertIdentical(t, d, str.Decimal)
+			})
+		}
+	}
+
+	if _, err := json.Marshal(StringDecimal{wrap(zero().SetNaN(false))}); err == nil {
+		t.Error("wanted an error marshalling NaN")
+	}
+}
+
+func TestDecimal_UnmarshalJSON(t *testing.T) {
+	for _, tc := range []struct {
+		in   string
+		want Decimal
+		ok   bool
+	}{
+		{`1.00`, NewCents(100), true},
+		{`"1.00"`, NewCents(100), true},
+		{`-1e3`, NewScalar(-1, -3), true},
+		{`null`, New(7), true},
+		{`"NaN"`, Decimal{}, false},
+		{`"Infinity"`, Decimal{}, false},
+		{`""`, Decimal{}, false},
+		{`true`, Decimal{}, false},
+	} {
+		t.Run(tc.in, func(t *testing.T) {
+			got := New(7)
+			err := json.Unmarshal([]byte(tc.in), &got)
+			if (err == nil) != tc.ok {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tc.ok {
+				assertIdentical(t, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestDecimal_MarshalBinary(t *testing.T) {
+	for _, d := range encodingCases {
+		t.Run(fmt.Sprint(d), func(t *testing.T) {
+			b, err := d.MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got Decimal
+			if err := got.UnmarshalBinary(b); err != nil {
+				t.Fatal(err)
+			}
+			assertIdentical(t, d, got)
+		})
+	}
+}
+
+func TestDecimal_UnmarshalBinaryErrors(t *testing.T) {
+	for _, b := range [][]byte{nil, {0}, {binaryVersion}, {binaryVersion, 0x80}, {binaryVersion, 4}} {
+		var d Decimal
+		if err := d.UnmarshalBinary(b); err == nil {
+			t.Errorf("%v: expected error", b)
+		}
+	}
+}
+
+func TestNonFiniteMarshalling(t *testing.T) {
+	inf := wrap(zero().SetInf(false))
+	if _, err := inf.MarshalText(); err != ErrNotFinite {
+		t.Errorf("text: wanted ErrNotFinite, got %v", err)
+	}
+	if _, err := json.Marshal(inf); err == nil {
+		t.Error("json: expected error")
+	}
+	if _, err := inf.MarshalBinary(); err != ErrNotFinite {
+		t.Errorf("binary: wanted ErrNotFinite, got %v", err)
+	}
+}
+
+func TestGobAndXML(t *testing.T) {
+	type record struct {
+		Amount Decimal
+		Rate   Decimal `xml:"rate,attr"`
+	}
+	in := record{NewCents(1050), Bp(25)}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatal(err)
+	}
+	var out record
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatal(err)
+	}
+	assertIdentical(t, in.Amount, out.Amount)
+	assertIdentical(t, in.Rate, out.Rate)
+
+	b, err := xml.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out = record{}
+	if err := xml.Unmarshal(b, &out); err != nil {
+		t.Fatal(err)
+	}
+	assertIdentical(t, in.Amount, out.Amount)
+	assertIdentical(t, in.Rate, out.Rate)
+}
+
+// assertIdentical checks both value and scale are the same.
+func assertIdentical(t *testing.T, want, got Decimal) {
+	t.Helper()
+	w, g := fmt.Sprint(want), fmt.Sprint(got)
+	if want.value != nil {
+		w = want.value.String()
+	}
+	if got.value != nil {
+		g = got.value.String()
+	}
+	if w != g {
+		t.Errorf("wanted %s, got %s", w, g)
+	}
+}
@@ -81,3 +81,102 @@ func FutureValueAnnuityDue(amountPerPeriod, rate Decimal, periods int) Decimal {
 func Deflate(amount, inflation Decimal, periods int) Decimal {
 	return amount.Div(inflation.AddInt(1).PowInt(periods))
 }
+
+// PaymentTiming determines whether payments are made at the end or beginning of each period.
+//
+// This corresponds to the "type" argument of the equivalent spreadsheet functions.
+type PaymentTiming int
+
+// Payment timings.
+const (
+	EndOfPeriod       PaymentTiming = 0 // an ordinary annuity
+	BeginningOfPeriod PaymentTiming = 1 // an annuity due
+)
+
+// factor returns 1 + rate*timing, which adjusts annuity values for payments made in advance.
+func (t PaymentTiming) factor(rate Decimal) Decimal {
+	if t == BeginningOfPeriod {
+		return rate.AddInt(1)
+	}
+	return NewInt(1)
+}
+
+// Payment calculates the payment per period for a loan or investment, as per the spreadsheet PMT function.
+//
+// This is [ presentValue * (1+rate)^periods + futureValue ] * rate / [ (1+rate*type) * (1 - (1+rate)^periods) ].
+// Note that rate should be per period, and money paid out is negative
+// i.e. borrowing 10000 gives a negative payment. If periods is zero there are no payments,
+// and zero is returned.
+func Payment(rate Decimal, periods int, presentValue, futureValue Decimal, when PaymentTiming) Decimal {
+	if periods == 0 {
+		return wrap(zero())
+	}
+	if rate.IsZero() {
+		return presentValue.Add(futureValue).Div(NewInt(-periods))
+	}
+	growth := rate.AddInt(1).PowInt(periods)
+	return presentValue.Mul(growth).Add(futureValue).Mul(rate).
+		Div(when.factor(rate).Mul(NewInt(1).Sub(growth)))
+}
+
+// PresentValue calculates the current value of a series of payments, as per the spreadsheet PV function.
+//
+// This is -[ futureValue + payment * (1+rate*type) * ((1+rate)^periods - 1) / rate ] / (1+rate)^periods.
+// Note that rate should be per period.
+func PresentValue(rate Decimal, periods int, payment, futureValue Decimal, when PaymentTiming) Decimal {
+	if rate.IsZero() {
+		return futureValue.Add(payment.Mul(NewInt(periods))).Neg()
+	}
+	growth := rate.AddInt(1).PowInt(periods)
+	annuity := payment.Mul(when.factor(rate)).Mul(growth.SubInt(1)).Div(rate)
+	return futureValue.Add(annuity).Div(growth.Neg())
+}
+
+// NumberOfPeriods calculates how many periods are needed to reach futureValue, as per the spreadsheet NPER function.
+//
+// This is ln[ (payment*(1+rate*type) - futureValue*rate) / (payment*(1+rate*type) + presentValue*rate) ] / ln(1+rate).
+// The result is generally fractional. If there is no solution, false is returned.
+func NumberOfPeriods(rate, payment, presentValue, futureValue Decimal, when PaymentTiming) (Decimal, bool) {
+	if rate.IsZero() {
+		if payment.IsZero() {
+			return wrap(zero()), false
+		}
+		return presentValue.Add(futureValue).Div(payment.Neg()), true
+	}
+	adjusted := payment.Mul(when.factor(rate))
+	num := adjusted.Sub(futureValue.Mul(rate))
+	denom := adjusted.Add(presentValue.Mul(rate))
+	if num.Sign()*denom.Sign() <= 0 {
+		return wrap(zero()), false
+	}
+	return num.Div(denom).Log().Div(rate.AddInt(1).Log()), true
+}
+
+// Rate calculates the interest rate per period of an annuity, as per the spreadsheet RATE function.
+//
+// The rate is found using GoalSeek to 10 significant figures, searching between -99% and 100% per period.
+// If there is no change of sign in that range, false is returned.
+func Rate(periods int, payment, presentValue, futureValue Decimal, when PaymentTiming) (Decimal, bool) {
+	// presentValue * (1+rate)^periods + payment * (1+rate*type) * ((1+rate)^periods - 1) / rate + futureValue = 0
+	f := func(rate Decimal) Decimal {
+		if rate.IsZero() {
+			return presentValue.Add(payment.Mul(NewInt(periods))).Add(futureValue)
+		}
+		growth := rate.AddInt(1).PowInt(periods)
+		annuity := payment.Mul(when.factor(rate)).Mul(growth.SubInt(1)).Div(rate)
+		return presentValue.Mul(growth).Add(annuity).Add(futureValue)
+	}
+	min, max := Pc(-99), NewInt(1)
+
+	// Bisection is unlikely to land exactly on the special case of zero.
+	if f(wrap(zero())).IsZero() {
+		return wrap(zero()), true
+	}
+
+	// Without a change of sign across the search range, GoalSeek may never give up.
+	if fmin, fmax := f(min), f(max); fmin.Sign()*fmax.Sign() > 0 {
+		return wrap(zero()), false
+	}
+
+	return GoalSeek(min, max, NewInt(0), 10, f)
+}
@@ -101,3 +101,129 @@ func TestEffectiveToPeriodicRate(t *testing.T) {
 		t.Errorf("expected %v, got %v", expected, actual)
 	}
 }
+
+func ExamplePayment() {
+	rate := Pc(8).Div(NewInt(12)) // 8% PA, paid monthly
+	periods := 10                 // 10 months
+	loan := New(10000)            // £10,000 borrowed
+	fmt.Printf("%.2f", Payment(rate, periods, loan, New(0), EndOfPeriod))
+	// Output: -1037.03
+}
+
+func ExamplePresentValue() {
+	rate := Pc(8).Div(NewInt(12)) // 8% PA, paid monthly
+	periods := 20 * 12            // 20 years
+	payment := New(500)           // £500 received per month
+	fmt.Print(PresentValue(rate, periods, payment, New(0), EndOfPeriod).RoundDP(2, ToNearestEven))
+	// Output: -59777.15
+}
+
+func ExampleNumberOfPeriods() {
+	rate := Pc(1)        // 1% per period
+	payment := New(-100) // £100 paid in each period
+	start := New(-1000)  // with £1000 paid in up front
+	target := New(10000) // to save £10,000
+	n, _ := NumberOfPeriods(rate, payment, start, target, BeginningOfPeriod)
+	fmt.Printf("%.4f", n)
+	// Output: 59.6739
+}
+
+func ExampleRate() {
+	periods := 4 * 12    // 4 years monthly
+	payment := New(-200) // £200 paid per month
+	loan := New(8000)    // to repay £8,000
+	rate, ok := Rate(periods, payment, loan, New(0), EndOfPeriod)
+	fmt.Printf("%.4f %t", rate.Mul(NewInt(12)), ok)
+	// Output: 0.0924 true
+}
+
+// Values in these tests are from a spreadsheet, rounded to the displayed precision.
+func TestPayment(t *testing.T) {
+	for i, tc := range []struct {
+		rate     Decimal
+		periods  int
+		pv, fv   Decimal
+		when     PaymentTiming
+		dp       int
+		expected Decimal
+	}{
+		{Pc(8).Div(NewInt(12)), 10, New(10000), New(0), EndOfPeriod, 2, NewCents(-103703)},
+		{Pc(8).Div(NewInt(12)), 10, New(10000), New(0), BeginningOfPeriod, 2, NewCents(-103016)},
+		{Pc(6).Div(NewInt(12)), 18 * 12, New(0), New(50000), EndOfPeriod, 2, NewCents(-12908)},
+		{Pc(5).Div(NewInt(12)), 360, New(200000), New(0), BeginningOfPeriod, 2, NewCents(-106919)},
+		{Pc(0), 10, New(1000), New(500), EndOfPeriod, 2, New(-150)},
+		{Pc(0), 0, New(1000), New(500), EndOfPeriod, 2, New(0)},
+		{Pc(0), 0, New(0), New(0), EndOfPeriod, 2, New(0)},
+		{Pc(8).Div(NewInt(12)), 0, New(10000), New(0), BeginningOfPeriod, 2, New(0)},
+	} {
+		actual := Payment(tc.rate, tc.periods, tc.pv, tc.fv, tc.when).RoundDP(tc.dp, ToNearestAway)
+		if !actual.Equals(tc.expected) {
+			t.Errorf("#%d expected %v, got %v", i, tc.expected, actual)
+		}
+	}
+}
+
+func TestPresentValue(t *testing.T) {
+	for i, tc := range []struct {
+		rate     Decimal
+		periods  int
+		pmt, fv  Decimal
+		when     PaymentTiming
+		expected Decimal
+	}{
+		{Pc(8).Div(NewInt(12)), 240, New(500), New(0), EndOfPeriod, NewCents(-5977715)},
+		{Pc(5), 10, New(-100), New(-1000), BeginningOfPeriod, NewCents(142470)},
+		{Pc(0), 10, New(-100), New(-1000), EndOfPeriod, New(2000)},
+	} {
+		actual := PresentValue(tc.rate, tc.periods, tc.pmt, tc.fv, tc.when).RoundDP(2, ToNearestAway)
+		if !actual.Equals(tc.expected) {
+			t.Errorf("#%d expected %v, got %v", i, tc.expected, actual)
+		}
+	}
+}
+
+func TestNumberOfPeriods(t *testing.T) {
+	for i, tc := range []struct {
+		rate, pmt, pv, fv Decimal
+		when              PaymentTiming
+		expected          Decimal
+		ok                bool
+	}{
+		{Pc(1), New(-100), New(-1000), New(10000), BeginningOfPeriod, NewScalar(596739, 4), true},
+		{Pc(1), New(-100), New(-1000), New(10000), EndOfPeriod, NewScalar(600821, 4), true},
+		{Pc(1), New(-100), New(-1000), New(0), EndOfPeriod, NewScalar(-95786, 4), true},
+		{Pc(0), New(-100), New(1000), New(0), EndOfPeriod, NewInt(10), true},
+		{Pc(0), New(0), New(1000), New(0), EndOfPeriod, NewInt(0), false},
+		{Pc(1), New(100), New(1000), New(0), EndOfPeriod, NewScalar(-95786, 4), true},
+		{Pc(1), New(-100), New(20000), New(0), EndOfPeriod, NewInt(0), false},
+	} {
+		actual, ok := NumberOfPeriods(tc.rate, tc.pmt, tc.pv, tc.fv, tc.when)
+		actual = actual.RoundDP(4, ToNearestAway)
+		if !actual.Equals(tc.expected) || ok != tc.ok {
+			t.Errorf("#%d expected (%v,%t), got (%v,%t)", i, tc.expected, tc.ok, actual, ok)
+		}
+	}
+}
+
+func TestRate(t *testing.T) {
+	for i, tc := range []struct {
+		periods  int
+		pmt, pv  Decimal
+		fv       Decimal
+		when     PaymentTiming
+		expected Decimal
+		ok       bool
+	}{
+		{48, New(-200), New(8000), New(0), EndOfPeriod, NewScalar(77015, 7), true},
+		{10, New(-1037), New(10000), New(0), EndOfPeriod, NewScalar(66609, 7), true},
+		{360, NewCents(-107364), New(200000), New(0), EndOfPeriod, NewScalar(41666, 7), true},
+		{10, New(-100), New(1000), New(0), EndOfPeriod, NewInt(0), true},
+		{10, New(100), New(1000), New(0), EndOfPeriod, NewInt(0), false},
+	} {
+		actual, ok := Rate(tc.periods, tc.pmt, tc.pv, tc.fv, tc.when)
+		actual = actual.RoundDP(7, ToNearestAway)
+		if !actual.Equals(tc.expected) || ok != tc.ok {
+			t.Errorf("#%d expected (%v,%t), got (%v,%t)", i, tc.expected, tc.ok, actual, ok)
+		}
+	}
+}
@@ -0,0 +1,350 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// NegativeStyle determines how negative amounts are written.
+type NegativeStyle int
+
+// Negative styles.
+const (
+	// MinusSign writes negative amounts with the locale's minus sign, placed as in the
+	// locale's MinusPosition e.g. -$1.00 or € -1,00.
+	MinusSign NegativeStyle = iota
+	// Parentheses encloses negative amounts in parentheses, as in accounting e.g. ($1.00).
+	Parentheses
+)
+
+// Formatter writes and reads decimals and monetary amounts in a given locale.
+//
+// The zero Formatter writes plain numbers such as -1234.56.
+type Formatter struct {
+	Locale   Locale
+	Negative NegativeStyle
+	Code     bool // use the currency's alphabetic code rather than its symbol
+}
+
+// NewFormatter returns a Formatter for a locale in the embedded table.
+func NewFormatter(tag string) (Formatter, bool) {
+	l, ok := LookupLocale(tag)
+	return Formatter{Locale: l}, ok
+}
+
+// Format writes a decimal, preserving its scale.
+//
+// NaN and infinite values are written as with fmt.
+func (f Formatter) Format(d Decimal) string {
+	return f.format(d, "", 0)
+}
+
+// FormatMoney writes a monetary amount with its currency symbol,
+// with at least as many decimal places as the currency's minor units.
+func (f Formatter) FormatMoney(m Money) string {
+	symbol := m.currency.Symbol
+	if f.Code || symbol == "" {
+		symbol = m.currency.Code
+	}
+	return f.format(m.amount, symbol, m.currency.MinorUnits)
+}
+
+// Parse reads a decimal written in the locale, preserving its scale.
+//
+// Parsing is lenient: grouping separators are optional, but must be correctly placed
+// if present, and any space is accepted in place of a space-like separator.
+// Negative amounts may use the locale's minus sign, '-' or parentheses, but not both.
+func (f Formatter) Parse(s string) (Decimal, error) {
+	return f.parse(s)
+}
+
+// ParseMoney reads a monetary amount written in the locale, with an optional
+// currency symbol or alphabetic code before or after the amount.
+func (f Formatter) ParseMoney(s string, c Currency) (Money, error) {
+	d, err := f.parse(s, c.Code, c.Symbol)
+	if err != nil {
+		return Money{}, err
+	}
+	return NewMoney(d, c), nil
+}
+
+func (f Formatter) format(d Decimal, symbol string, minDP int) string {
+	v := orZero(d).value
+	if !v.IsFinite() {
+		return fmt.Sprint(d)
+	}
+
+	digits := new(big.Int).Abs(mantissa(v)).String()
+	scale := v.Scale()
+	if scale < minDP {
+		digits += strings.Repeat("0", minDP-scale)
+		scale = minDP
+	}
+	if scale < 0 {
+		digits += strings.Repeat("0", -scale)
+		scale = 0
+	}
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+
+	s := f.Locale.group(digits[:len(digits)-scale])
+	if scale > 0 {
+		s += f.Locale.decimal() + digits[len(digits)-scale:]
+	}
+
+	if v.Sign() < 0 && f.Negative == Parentheses {
+		return "(" + f.symbol(s, symbol, "") + ")"
+	}
+	if v.Sign() < 0 {
+		return f.symbol(s, symbol, f.Locale.minus())
+	}
+	return f.symbol(s, symbol, "")
+}
+
+// symbol adds the currency symbol, if any, and the minus sign, if any, to a formatted number.
+func (f Formatter) symbol(s, symbol, minus string) string {
+	if symbol == "" {
+		return minus + s
+	}
+	spacing := f.Locale.SymbolSpacing
+	if f.Code && spacing == "" {
+		spacing = nbsp
+	}
+	switch {
+	case !f.Locale.SymbolFirst:
+		return minus + s + spacing + symbol
+	case minus != "" && f.Locale.MinusPosition == MinusAfterSymbol:
+		return symbol + spacing + minus + s
+	case minus != "" && f.Locale.MinusPosition == MinusForSpacing:
+		return symbol + minus + s
+	}
+	return minus + symbol + spacing + s
+}
+
+// group inserts grouping separators into the integer digits.
+func (l Locale) group(digits string) string {
+	if l.Group == "" || len(l.Grouping) == 0 {
+		return digits
+	}
+	min := l.MinimumGroupingDigits
+	if min < 1 {
+		min = 1
+	}
+	if len(digits)-l.Grouping[0] < min {
+		return digits
+	}
+
+	var groups []string
+	for i := 0; len(digits) > 0; i++ {
+		size := l.groupSize(i)
+		if size <= 0 || size >= len(digits) {
+			groups = append(groups, digits)
+			break
+		}
+		groups = append(groups, digits[len(digits)-size:])
+		digits = digits[:len(digits)-size]
+	}
+	for i, j := 0, len(groups)-1; i < j; i, j = i+1, j-1 {
+		groups[i], groups[j] = groups[j], groups[i]
+	}
+	return strings.Join(groups, l.Group)
+}
+
+// groupSize returns the size of the ith group from the decimal mark.
+func (l Locale) groupSize(i int) int {
+	if i >= len(l.Grouping) {
+		i = len(l.Grouping) - 1
+	}
+	return l.Grouping[i]
+}
+
+func (l Locale) decimal() string {
+	if l.Decimal == "" {
+		return "."
+	}
+	return l.Decimal
+}
+
+func (l Locale) minus() string {
+	if l.Minus == "" {
+		return "-"
+	}
+	return l.Minus
+}
+
+func (f Formatter) parse(s string, symbols ...string) (Decimal, error) {
+	p := localeParser{input: s, end: len(s), locale: f.Locale}
+	return p.parse(symbols)
+}
+
+type localeParser struct {
+	input    string
+	pos, end int
+	locale   Locale
+	neg      bool
+}
+
+func (p *localeParser) parse(symbols []string) (Decimal, error) {
+	p.trimSpace()
+	if p.pos < p.end && p.input[p.pos] == '(' && p.input[p.end-1] == ')' {
+		p.neg = true
+		p.pos++
+		p.end--
+		p.trimSpace()
+	}
+	if err := p.minus(); err != nil {
+		return Decimal{}, err
+	}
+	p.symbol(symbols)
+	if err := p.minus(); err != nil {
+		return Decimal{}, err
+	}
+
+	digits, err := p.number()
+	if err != nil {
+		return Decimal{}, err
+	}
+	if p.neg {
+		digits = "-" + digits
+	}
+	return Parse(digits)
+}
+
+// number scans the digits, decimal mark and separators, returning the number in the form accepted by Parse.
+func (p *localeParser) number() (string, error) {
+	var digits []byte
+	var groups []int
+	group, fraction := 0, false
+	start := p.pos
+	for p.pos < p.end {
+		r, size := utf8.DecodeRuneInString(p.input[p.pos:p.end])
+		switch {
+		case r < utf8.RuneSelf && isDigit(byte(r)):
+			digits = append(digits, byte(r))
+			group++
+		case !fraction && strings.HasPrefix(p.input[p.pos:p.end], p.locale.decimal()):
+			if err := p.checkGroups(append(groups, group)); err != nil {
+				return "", err
+			}
+			digits = append(digits, '.')
+			fraction = true
+			size = len(p.locale.decimal())
+		case !fraction && p.isGroup(r):
+			if group == 0 {
+				return "", p.errorf("misplaced grouping separator")
+			}
+			groups = append(groups, group)
+			group = 0
+			size = len(p.locale.Group)
+			if !strings.HasPrefix(p.input[p.pos:p.end], p.locale.Group) {
+				_, size = utf8.DecodeRuneInString(p.input[p.pos:p.end])
+			}
+		default:
+			return "", p.unexpected()
+		}
+		p.pos += size
+	}
+	if !fraction {
+		if err := p.checkGroups(append(groups, group)); err != nil {
+			return "", err
+		}
+	}
+	if len(digits) == 0 || (len(digits) == 1 && fraction) {
+		p.pos = start
+		return "", p.errorf("no digits")
+	}
+	return string(digits), nil
+}
+
+// checkGroups validates the sizes of the groups of integer digits, from left to right.
+func (p *localeParser) checkGroups(groups []int) error {
+	if len(groups) < 2 {
+		return nil
+	}
+	for i := range groups {
+		size := p.locale.groupSize(len(groups) - 1 - i)
+		if groups[i] != size && (i > 0 || groups[i] > size) {
+			return p.errorf("misplaced grouping separator")
+		}
+	}
+	return nil
+}
+
+// isGroup returns true if the rune at the current position is a grouping separator,
+// allowing any space for a space-like separator and an apostrophe for a right single quote.
+func (p *localeParser) isGroup(r rune) bool {
+	sep := p.locale.Group
+	if sep == "" || len(p.locale.Grouping) == 0 {
+		return false
+	}
+	if strings.HasPrefix(p.input[p.pos:p.end], sep) {
+		return true
+	}
+	g, _ := utf8.DecodeRuneInString(sep)
+	return (unicode.IsSpace(g) && unicode.IsSpace(r)) || (g == '’' && r == '\'')
+}
+
+// minus scans an optional minus sign, which is an error if the amount is already negative
+// e.g. in parentheses.
+func (p *localeParser) minus() error {
+	for _, m := range []string{p.locale.minus(), "-", "−"} {
+		if strings.HasPrefix(p.input[p.pos:p.end], m) {
+			if p.neg {
+				return p.unexpected()
+			}
+			p.neg = true
+			p.pos += len(m)
+			p.trimSpace()
+			return nil
+		}
+	}
+	return nil
+}
+
+// symbol scans an optional currency symbol at the start or end.
+func (p *localeParser) symbol(symbols []string) {
+	for _, sym := range symbols {
+		if sym == "" {
+			continue
+		}
+		if strings.HasPrefix(p.input[p.pos:p.end], sym) {
+			p.pos += len(sym)
+		} else if strings.HasSuffix(p.input[p.pos:p.end], sym) {
+			p.end -= len(sym)
+		} else {
+			continue
+		}
+		p.trimSpace()
+		return
+	}
+}
+
+func (p *localeParser) trimSpace() {
+	for p.pos < p.end {
+		r, size := utf8.DecodeRuneInString(p.input[p.pos:p.end])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		p.pos += size
+	}
+	for p.pos < p.end {
+		r, size := utf8.DecodeLastRuneInString(p.input[p.pos:p.end])
+		if !unicode.IsSpace(r) {
+			break
+		}
+		p.end -= size
+	}
+}
+
+func (p *localeParser) unexpected() error {
+	r, _ := utf8.DecodeRuneInString(p.input[p.pos:])
+	return p.errorf("unexpected %q", r)
+}
+
+func (p *localeParser) errorf(format string, args ...interface{}) error {
+	return &ParseError{Input: p.input, Offset: p.pos, Msg: fmt.Sprintf(format, args...)}
+}
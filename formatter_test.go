@@ -0,0 +1,191 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func ExampleFormatter_FormatMoney() {
+	f, _ := NewFormatter("de-DE")
+	fmt.Printf("%q\n", f.FormatMoney(NewMoney(NewCents(-123456789), MustCurrency("EUR"))))
+
+	f, _ = NewFormatter("en-US")
+	f.Negative = Parentheses
+	fmt.Println(f.FormatMoney(NewMoney(NewCents(-123456789), MustCurrency("USD"))))
+
+	f, _ = NewFormatter("en-IN")
+	fmt.Println(f.FormatMoney(NewMoney(NewCents(123456789), MustCurrency("INR"))))
+	// Output:
+	// "-1.234.567,89\u00a0€"
+	// ($1,234,567.89)
+	// ₹12,34,567.89
+}
+
+func ExampleFormatter_Parse() {
+	f, _ := NewFormatter("fr-FR")
+	d, err := f.Parse("-1 234 567,89")
+	fmt.Println(d, err)
+	// Output: -1234567.89 <nil>
+}
+
+func TestFormatter_Format(t *testing.T) {
+	for _, tc := range []struct {
+		tag string
+		d   Decimal
+		w   string
+	}{
+		{"", NewCents(-123456789), "-1234567.89"},
+		{"", Decimal{}, "0"},
+		{"en-US", NewCents(-123456789), "-1,234,567.89"},
+		{"en-US", NewCents(12345), "123.45"},
+		{"en-US", NewCents(5), "0.05"},
+		{"en-US", NewScalar(5, 4), "0.0005"},
+		{"en-US", NewScalar(12, -5), "1,200,000"},
+		{"en-US", NewInt(1000), "1,000"},
+		{"en-US", NewInt(100), "100"},
+		{"en-IN", NewInt(1234567890), "1,23,45,67,890"},
+		{"en-IN", NewInt(12345), "12,345"},
+		{"de-DE", NewCents(123456789), "1.234.567,89"},
+		{"de-CH", NewCents(123456789), "1’234’567.89"},
+		{"fr-FR", NewCents(123456789), "1\u202f234\u202f567,89"},
+		{"es-ES", NewCents(123456), "1234,56"},
+		{"es-ES", NewCents(1234567), "12.345,67"},
+		{"sv-SE", NewCents(-123456), "−1\u00a0234,56"},
+	} {
+		l, _ := LookupLocale(tc.tag)
+		if got := (Formatter{Locale: l}).Format(tc.d); got != tc.w {
+			t.Errorf("%s %v: wanted %q, got %q", tc.tag, tc.d, tc.w, got)
+		}
+	}
+}
+
+func TestFormatter_FormatMoney(t *testing.T) {
+	for _, tc := range []struct {
+		tag      string
+		m        Money
+		negative NegativeStyle
+		code     bool
+		w        string
+	}{
+		{"en-US", NewMoney(NewCents(123456), MustCurrency("USD")), MinusSign, false, "$1,234.56"},
+		{"en-US", NewMoney(NewCents(-123456), MustCurrency("USD")), MinusSign, false, "-$1,234.56"},
+		{"en-US", NewMoney(NewCents(-123456), MustCurrency("USD")), Parentheses, false, "($1,234.56)"},
+		{"en-US", NewMoney(NewCents(123456), MustCurrency("USD")), MinusSign, true, "USD\u00a01,234.56"},
+		{"en-US", NewMoney(NewInt(5), MustCurrency("USD")), MinusSign, false, "$5.00"},
+		{"en-US", NewMoney(NewScalar(12345, 4), MustCurrency("USD")), MinusSign, false, "$1.2345"},
+		{"en-GB", NewMoney(NewInt(1234), MustCurrency("JPY")), MinusSign, false, "¥1,234"},
+		{"en-GB", NewMoney(NewInt(1), MustCurrency("KWD")), MinusSign, true, "KWD\u00a01.000"},
+		{"de-DE", NewMoney(NewCents(-123456), MustCurrency("EUR")), Parentheses, false, "(1.234,56\u00a0€)"},
+		{"de-DE", NewMoney(NewCents(123456), MustCurrency("EUR")), MinusSign, true, "1.234,56\u00a0EUR"},
+		{"de-CH", NewMoney(NewCents(123456), MustCurrency("CHF")), MinusSign, false, "CHF\u00a01’234.56"},
+		{"de-CH", NewMoney(NewCents(-100), MustCurrency("CHF")), MinusSign, false, "CHF-1.00"},
+		{"de-CH", NewMoney(NewCents(-100), MustCurrency("CHF")), Parentheses, false, "(CHF\u00a01.00)"},
+		{"nl-NL", NewMoney(NewCents(-100), MustCurrency("EUR")), MinusSign, false, "€\u00a0-1,00"},
+		{"nl-NL", NewMoney(NewCents(-100), MustCurrency("EUR")), MinusSign, true, "EUR\u00a0-1,00"},
+		{"pt-BR", NewMoney(NewCents(-100), MustCurrency("BRL")), MinusSign, false, "-R$\u00a01,00"},
+		{"fr-FR", NewMoney(NewCents(-100), MustCurrency("EUR")), MinusSign, false, "-1,00\u00a0€"},
+		{"pt-BR", NewMoney(NewCents(123456), MustCurrency("BRL")), MinusSign, false, "R$\u00a01.234,56"},
+		{"hi-IN", NewMoney(NewCents(10000000), MustCurrency("INR")), MinusSign, false, "₹1,00,000.00"},
+	} {
+		l := MustLocale(tc.tag)
+		f := Formatter{Locale: l, Negative: tc.negative, Code: tc.code}
+		if got := f.FormatMoney(tc.m); got != tc.w {
+			t.Errorf("%s %v: wanted %q, got %q", tc.tag, tc.m, tc.w, got)
+		}
+	}
+}
+
+func TestFormatter_Parse(t *testing.T) {
+	for _, tc := range []struct {
+		tag string
+		s   string
+		w   Decimal
+		err string
+	}{
+		{"", "-1234567.89", NewCents(-123456789), ""},
+		{"en-US", "1,234,567.89", NewCents(123456789), ""},
+		{"en-US", "1234567.89", NewCents(123456789), ""},
+		{"en-US", " (1,234.50) ", NewCents(-123450), ""},
+		{"en-US", "-0.5", NewScalar(-5, 1), ""},
+		{"en-US", ".5", NewScalar(5, 1), ""},
+		{"en-US", "1,23,456", Decimal{}, "misplaced grouping separator at offset 8"},
+		{"en-US", "12,3456", Decimal{}, "misplaced grouping separator at offset 7"},
+		{"en-US", "1,234,", Decimal{}, "misplaced grouping separator at offset 6"},
+		{"en-US", ",123", Decimal{}, "misplaced grouping separator at offset 0"},
+		{"en-US", "1,234.5,6", Decimal{}, "unexpected ',' at offset 7"},
+		{"en-US", "1.2.3", Decimal{}, "unexpected '.' at offset 3"},
+		{"en-US", "", Decimal{}, "no digits at offset 0"},
+		{"en-US", ".", Decimal{}, "no digits at offset 0"},
+		{"en-US", "12x", Decimal{}, "unexpected 'x' at offset 2"},
+		{"en-US", "(-1)", Decimal{}, "unexpected '-' at offset 1"},
+		{"en-US", "(1-)", Decimal{}, "unexpected '-' at offset 2"},
+		{"en-US", "--1", Decimal{}, "unexpected '-' at offset 1"},
+		{"en-IN", "12,34,567.89", NewCents(123456789), ""},
+		{"en-IN", "1,234,567.89", Decimal{}, "misplaced grouping separator at offset 9"},
+		{"de-DE", "-1.234.567,89", NewCents(-123456789), ""},
+		{"de-DE", "1,5", NewScalar(15, 1), ""},
+		{"de-CH", "1'234.50", NewCents(123450), ""},
+		{"fr-FR", "1 234,56", NewCents(123456), ""},
+		{"fr-FR", "1\u00a0234,56", NewCents(123456), ""},
+		{"sv-SE", "−1 234,56", NewCents(-123456), ""},
+	} {
+		l, _ := LookupLocale(tc.tag)
+		got, err := (Formatter{Locale: l}).Parse(tc.s)
+		if tc.err != "" {
+			if err == nil || !strings.HasSuffix(err.Error(), tc.err) {
+				t.Errorf("%s %q: wanted error %q, got %v, %v", tc.tag, tc.s, tc.err, got, err)
+			}
+			continue
+		}
+		if err != nil || !got.Equals(tc.w) || fmt.Sprint(got) != fmt.Sprint(tc.w) {
+			t.Errorf("%s %q: wanted %v, got %v, %v", tc.tag, tc.s, tc.w, got, err)
+		}
+	}
+}
+
+func TestFormatter_ParseMoney(t *testing.T) {
+	chf, usd := MustCurrency("CHF"), MustCurrency("USD")
+	for _, tc := range []struct {
+		tag string
+		s   string
+		c   Currency
+		w   Decimal
+	}{
+		{"en-US", "$1,234.56", usd, NewCents(123456)},
+		{"en-US", "-$1,234.56", usd, NewCents(-123456)},
+		{"en-US", "($1,234.56)", usd, NewCents(-123456)},
+		{"en-US", "USD 1,234.56", usd, NewCents(123456)},
+		{"en-US", "1,234.56 USD", usd, NewCents(123456)},
+		{"de-CH", "CHF-1’234.56", chf, NewCents(-123456)},
+		{"nl-NL", "€ -1.234,56", MustCurrency("EUR"), NewCents(-123456)},
+		{"de-DE", "1.234,56 €", MustCurrency("EUR"), NewCents(123456)},
+	} {
+		got, err := (Formatter{Locale: MustLocale(tc.tag)}).ParseMoney(tc.s, tc.c)
+		if err != nil || !got.Amount().Equals(tc.w) || got.Currency() != tc.c {
+			t.Errorf("%s %q: wanted %v, got %v, %v", tc.tag, tc.s, tc.w, got, err)
+		}
+	}
+}
+
+func TestFormatter_RoundTrip(t *testing.T) {
+	amounts := []Decimal{NewCents(0), NewCents(-1), NewCents(123456789012), NewScalar(-5, 4), NewInt(1000), NewCents(100000)}
+	for _, tag := range Locales() {
+		for _, negative := range []NegativeStyle{MinusSign, Parentheses} {
+			for _, code := range []bool{false, true} {
+				f := Formatter{Locale: MustLocale(tag), Negative: negative, Code: code}
+				for _, d := range amounts {
+					m := NewMoney(d, MustCurrency("EUR"))
+					s := f.FormatMoney(m)
+					got, err := f.ParseMoney(s, m.Currency())
+					if err != nil || !got.Amount().Equals(d) {
+						t.Errorf("%s: %v formatted as %q parsed as %v, %v", tag, d, s, got, err)
+					}
+					if got, err := f.Parse(f.Format(d)); err != nil || fmt.Sprint(got) != fmt.Sprint(d) {
+						t.Errorf("%s: %v formatted as %q parsed as %v, %v", tag, d, f.Format(d), got, err)
+					}
+				}
+			}
+		}
+	}
+}
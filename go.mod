@@ -1,3 +1,5 @@
 module github.com/mpwalkerdine/money
 
+go 1.13
+
 require github.com/ericlagergren/decimal v0.0.0-20180805034518-32e0aeedcccc
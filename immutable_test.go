@@ -0,0 +1,427 @@
+package money
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"go/ast"
+	goparser "go/parser"
+	"go/token"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	eld "github.com/ericlagergren/decimal"
+)
+
+// TestImmutable calls every exported function and method with a variety of arguments, checking
+// that no Decimal passed in, returned or passed to a callback is modified, then or by a later call.
+func TestImmutable(t *testing.T) {
+	var shared tracker
+	shared.walk(reflect.ValueOf([]Decimal{maxVal, minVal, ten, two, half}))
+	shared.walk(reflect.ValueOf(cashIncrements))
+
+	covered := map[string]bool{}
+	for name, fn := range immutableFuncs {
+		covered[name] = true
+		for variant := 0; variant < 3; variant++ {
+			checkImmutable(t, name, variant, func(tr *tracker) reflect.Value { return reflect.ValueOf(fn) })
+		}
+	}
+
+	for _, recv := range immutableReceivers() {
+		typ := reflect.TypeOf(recv)
+		for i := 0; i < reflect.PtrTo(typ).NumMethod(); i++ {
+			method := reflect.PtrTo(typ).Method(i).Name
+			name := typ.Name() + "." + method
+			covered[name] = true
+			for variant := 0; variant < 3; variant++ {
+				checkImmutable(t, name, variant, func(tr *tracker) reflect.Value {
+					ptr := reflect.New(typ)
+					ptr.Elem().Set(reflect.ValueOf(recv))
+					tr.walk(ptr.Elem())
+					return ptr.MethodByName(method)
+				})
+			}
+		}
+	}
+
+	for _, name := range exportedFuncs(t) {
+		if !covered[name] {
+			t.Errorf("%s is not covered, add it to immutableFuncs or immutableReceivers", name)
+		}
+	}
+	shared.check(t, "package variables")
+}
+
+// checkImmutable calls a function twice with the same generated arguments.
+func checkImmutable(t *testing.T, name string, variant int, fn func(*tracker) reflect.Value) {
+	var tr tracker
+	f := fn(&tr)
+	args := make([]reflect.Value, f.Type().NumIn())
+	for i := range args {
+		args[i] = tr.arg(f.Type().In(i), variant+i)
+	}
+	for i := 0; i < 2; i++ {
+		func() {
+			defer func() {
+				if r := recover(); r != nil && !expectedPanic(name, r) {
+					t.Errorf("%s #%d: unexpected panic: %v", name, variant, r)
+				}
+			}()
+			var results []reflect.Value
+			if f.Type().IsVariadic() {
+				results = f.CallSlice(args)
+			} else {
+				results = f.Call(args)
+			}
+			for _, r := range results {
+				tr.walk(r)
+			}
+		}()
+	}
+	tr.check(t, fmt.Sprintf("%s #%d", name, variant))
+}
+
+// expectedPanic returns true if a function is expected to panic for some of the generated arguments.
+func expectedPanic(name string, r interface{}) bool {
+	if strings.HasPrefix(name, "Must") {
+		return true
+	}
+	_, nan := r.(eld.ErrNaN)
+	return nan && immutableNaN[name]
+}
+
+// immutableNaN are the functions which take a power or logarithm of a negative number for some
+// of the generated arguments, e.g. a rate of -300%, panicking with decimal.ErrNaN as for 0/0.
+var immutableNaN = map[string]bool{
+	"Bond.CleanPrice":                    true,
+	"Bond.DirtyPrice":                    true,
+	"EffectiveToNominalRate":             true,
+	"EffectiveToPeriodicRate":            true,
+	"NumberOfPeriods":                    true,
+	"XNPV":                               true,
+	"XNPVWith":                           true,
+	"YieldSensitivity.Convexity":         true,
+	"YieldSensitivity.DV01":              true,
+	"YieldSensitivity.EffectiveDuration": true,
+	"YieldSensitivity.MacaulayDuration":  true,
+	"YieldSensitivity.ModifiedDuration":  true,
+	"YieldSensitivity.Price":             true,
+}
+
+// tracker records the state of every decimal value it sees.
+type tracker struct {
+	values []dec
+	state  map[dec]string
+}
+
+func (tr *tracker) track(d Decimal) {
+	if d.value == nil {
+		return
+	}
+	if tr.state == nil {
+		tr.state = map[dec]string{}
+	}
+	if _, ok := tr.state[d.value]; !ok {
+		tr.values = append(tr.values, d.value)
+		tr.state[d.value] = snapshot(d.value)
+	}
+}
+
+func (tr *tracker) check(t *testing.T, name string) {
+	t.Helper()
+	for _, v := range tr.values {
+		if got := snapshot(v); got != tr.state[v] {
+			t.Errorf("%s: value modified from %s to %s", name, tr.state[v], got)
+		}
+	}
+}
+
+func snapshot(v dec) string {
+	ctx := v.Context
+	return fmt.Sprintf("%s (scale %d, precision %d, %v, conditions %q)", v, v.Scale(), ctx.Precision, ctx.RoundingMode, ctx.Conditions)
+}
+
+// walk tracks the decimals in a value.
+func (tr *tracker) walk(v reflect.Value) {
+	if !v.IsValid() || !v.CanInterface() {
+		return
+	}
+	switch x := v.Interface().(type) {
+	case Decimal:
+		tr.track(x)
+		return
+	case Money:
+		tr.track(x.amount)
+		return
+	case Calc:
+		tr.track(x.value)
+		return
+	}
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			tr.walk(v.Elem())
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			tr.walk(v.Field(i))
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			tr.walk(v.Index(i))
+		}
+	case reflect.Map:
+		for _, k := range v.MapKeys() {
+			tr.walk(v.MapIndex(k))
+		}
+	}
+}
+
+// arg generates an argument of a given type, tracking any decimals.
+func (tr *tracker) arg(t reflect.Type, i int) reflect.Value {
+	var v interface{}
+	switch t {
+	case reflect.TypeOf(Decimal{}):
+		v = immutableDecimals[i%len(immutableDecimals)]()
+	case reflect.TypeOf([]Decimal{}):
+		v = []Decimal{New(-100), NewCents(6050), Decimal{}, NewScalar(6, 1)}
+	case reflect.TypeOf(Money{}):
+		v = NewMoney(NewScalar(123456, 3), MustCurrency("GBP"))
+	case reflect.TypeOf(Currency{}):
+		v = MustCurrency([]string{"GBP", "JPY", "KWD"}[i%3])
+	case reflect.TypeOf(Loan{}):
+		v = Loan{Principal: New(1000), Rate: Pc(1), Periods: 12, Balloon: New(100), DP: 2}
+	case reflect.TypeOf([]Tax{}):
+		v = []Tax{{Name: "GST", Rate: Pc(5)}, {Name: "QST", Rate: NewScalar(9975, 5), Compound: i%2 == 0}}
+	case reflect.TypeOf([]CashFlow{}):
+		v = []CashFlow{
+			{Date: date(2024, time.January, 1), Amount: New(-1000)},
+			{Date: date(2024, time.July, 1), Amount: NewCents(55050)},
+			{Date: date(2025, time.January, 1), Amount: New(550)},
+		}
+	case reflect.TypeOf(time.Time{}):
+		v = date(2024+i%2, time.Month(3+i%7), 15+i%17)
+	case reflect.TypeOf(time.Month(0)):
+		v = time.Month(1 + i%12)
+	case reflect.TypeOf(time.Weekday(0)):
+		v = time.Weekday(i % 7)
+	case reflect.TypeOf(0):
+		v = 1 + i%4
+	case reflect.TypeOf(int64(0)):
+		v = []int64{12345, -5, 0}[i%3]
+	case reflect.TypeOf(RoundingMode(0)):
+		v = []RoundingMode{ToNearestEven, AwayFromZero, ToZero}[i%3]
+	case reflect.TypeOf(""):
+		v = []string{"1.5", "GBP", "en-GB"}[i%3]
+	case reflect.TypeOf(false):
+		v = i%2 == 0
+	case reflect.TypeOf('f'):
+		v = []rune{'f', 'v', 'c'}[i%3]
+	case reflect.TypeOf([]byte{}):
+		v = []byte(`"1.25"`)
+	case reflect.TypeOf(func(Decimal) Decimal { return Decimal{} }):
+		v = func(x Decimal) Decimal {
+			tr.track(x)
+			y := x.Mul(x).Sub(NewInt(2))
+			tr.track(y)
+			return y
+		}
+	case reflect.TypeOf((*DayCount)(nil)).Elem():
+		v = Actual365Fixed{}
+	case reflect.TypeOf((*fmt.State)(nil)).Elem():
+		v = &testState{}
+	case reflect.TypeOf((*error)(nil)).Elem():
+		v = ErrInexact
+	case reflect.TypeOf((*interface{})(nil)).Elem():
+		v = []interface{}{"1.25", int64(3), 1.5}[i%3]
+	default:
+		switch t.Kind() {
+		case reflect.Int, reflect.Uint8, reflect.Uint32:
+			// Enumerations such as AllocationStrategy and PaymentTiming.
+			return reflect.ValueOf(i % 2).Convert(t)
+		}
+		panic(fmt.Sprintf("no argument of type %s", t))
+	}
+	rv := reflect.ValueOf(v)
+	tr.walk(rv)
+	return rv
+}
+
+// immutableDecimals are the decimal arguments, chosen in turn.
+var immutableDecimals = []func() Decimal{
+	func() Decimal { return NewCents(12345) },
+	func() Decimal { return Pc(5) },
+	func() Decimal { return NewInt(-3) },
+	func() Decimal { return NewScalar(1, 3) },
+	func() Decimal { return Decimal{} },
+	func() Decimal { return NewScalar(25, -2) },
+}
+
+// immutableFuncs are the exported functions of the package.
+var immutableFuncs = map[string]interface{}{
+	"AddTax":                     AddTax,
+	"Amortize":                   Amortize,
+	"Bp":                         Bp,
+	"Currencies":                 Currencies,
+	"Deflate":                    Deflate,
+	"EasterHoliday":              EasterHoliday,
+	"EffectiveRate":              EffectiveRate,
+	"EffectiveToNominalRate":     EffectiveToNominalRate,
+	"EffectiveToPeriodicRate":    EffectiveToPeriodicRate,
+	"ExtractTax":                 ExtractTax,
+	"FixedHoliday":               FixedHoliday,
+	"FutureValue":                FutureValue,
+	"FutureValueAnnuityDue":      FutureValueAnnuityDue,
+	"FutureValueOrdinaryAnnuity": FutureValueOrdinaryAnnuity,
+	"GoalSeek": func(target Decimal, f func(Decimal) Decimal) (Decimal, bool) {
+		// Arbitrary bounds may never bracket a solution.
+		return GoalSeek(NewInt(0), NewInt(3), target, 3, f)
+	},
+	"GoalSeekWith": func(target Decimal, f func(Decimal) Decimal) []Decimal {
+		var results []Decimal
+		for _, method := range []SolveMethod{Bisection, Brent, Illinois, Secant, Newton} {
+			opts := SolveOptions{Method: method, Precision: 3}
+			result, _ := GoalSeekWith(opts, NewInt(0), NewInt(3), target, f)
+			results = append(results, result)
+		}
+		opts := SolveOptions{Method: Newton, Precision: 3, Derivative: func(x Decimal) Decimal { return x.Mul(two) }}
+		result, _ := GoalSeekWith(opts, NewInt(0), NewInt(3), target, f)
+		return append(results, result)
+	},
+	"IRR":                    IRR,
+	"Locales":                Locales,
+	"LookupCurrency":         LookupCurrency,
+	"LookupLocale":           LookupLocale,
+	"MIRR":                   MIRR,
+	"Max":                    Max,
+	"Min":                    Min,
+	"MustCurrency":           MustCurrency,
+	"MustLocale":             MustLocale,
+	"MustParse":              MustParse,
+	"NPV":                    NPV,
+	"New":                    New,
+	"NewCalc":                NewCalc,
+	"NewCents":               NewCents,
+	"NewFormatter":           NewFormatter,
+	"NewInt":                 NewInt,
+	"NewMinor":               NewMinor,
+	"NewMoney":               NewMoney,
+	"NewScalar":              NewScalar,
+	"NominalToEffectiveRate": NominalToEffectiveRate,
+	"NominalToRealRate":      NominalToRealRate,
+	"NumberOfPeriods":        NumberOfPeriods,
+	"Parse":                  Parse,
+	"Payment":                Payment,
+	"Pc":                     Pc,
+	"Pm":                     Pm,
+	"PresentValue":           PresentValue,
+	"Rate":                   Rate,
+	"RealToNominalRate":      RealToNominalRate,
+	"RecompoundRate":         RecompoundRate,
+	"WeekdayHoliday":         WeekdayHoliday,
+	"Solve": func(target Decimal, f func(Decimal) Decimal) []SolveResult {
+		var results []SolveResult
+		for _, method := range []SolveMethod{Bisection, Brent, Illinois, Secant, Newton} {
+			opts := SolveOptions{Method: method, Precision: 3, MaxEvaluations: 50}
+			result, _ := Solve(context.Background(), opts, NewInt(0), NewInt(3), target, f)
+			results = append(results, result)
+		}
+		return results
+	},
+	"XIRR":     XIRR,
+	"XIRRWith": XIRRWith,
+	"XNPV":     XNPV,
+	"XNPVWith": XNPVWith,
+}
+
+// immutableReceivers returns a value of each exported type with methods.
+func immutableReceivers() []interface{} {
+	bond := Bond{Face: New(100), CouponRate: Pc(5), Frequency: 2, Issue: date(2020, time.January, 15), Maturity: date(2030, time.January, 15)}
+	return []interface{}{
+		NewCents(-12345),
+		NewMoney(NewCents(12345), MustCurrency("GBP")),
+		NullDecimal{Decimal: NewCents(5), Valid: true},
+		StringDecimal{NewCents(-250)},
+		NewCalc(NewCents(250)),
+		Context{Precision: 10},
+		MustCurrency("CHF"),
+		Formatter{Locale: MustLocale("de-DE")},
+		Speller{},
+		UKCalendar,
+		FixedHoliday("New Year's Day", time.January, 1, NextBusinessDay),
+		Asset{Cost: New(10000), Salvage: New(1000), Life: 5, Convention: HalfYear, DP: 2},
+		Loan{Principal: New(1000), Rate: Pc(1), Periods: 12},
+		Asset{Cost: New(1000), Convention: FullMonth},
+		bond,
+		Bond{Face: New(100), CouponRate: Pc(5), Issue: date(2020, time.January, 15), Maturity: date(2030, time.January, 15)},
+		bond.Sensitivity(date(2024, time.March, 1)),
+		TaxPolicy{DP: 2, Level: PerLine},
+		AddTax(New(100), Tax{Rate: Pc(20)}),
+		Invoice{
+			Lines: []LineItem{
+				{Quantity: NewInt(3), UnitPrice: NewCents(999), Taxes: []Tax{{Rate: Pc(20)}}},
+				{Quantity: NewInt(1), UnitPrice: New(50), Discount: Discount{Percent: Pc(10)}, Taxes: []Tax{{Rate: Pc(5)}}},
+			},
+			Discount:      Discount{Amount: New(5)},
+			Shipping:      NewCents(495),
+			ShippingTaxes: []Tax{{Rate: Pc(20)}},
+			DP:            2,
+		},
+		Actual360{},
+		Actual365Fixed{},
+		Actual365L{Frequency: 1},
+		ActualActualICMA{Frequency: 2},
+		ActualActualISDA{},
+		Thirty360EPlus{},
+		Thirty360European{},
+		Thirty360US{},
+		ArithmeticError{Op: "div", Conditions: DivisionByZero},
+		CurrencyMismatchError{},
+		ParseError{Input: "1.2.3", Offset: 3, Msg: "unexpected '.'"},
+	}
+}
+
+// exportedFuncs lists the exported functions and methods declared in the package,
+// as Func or Type.Method.
+func exportedFuncs(t *testing.T) []string {
+	pkgs, err := goparser.ParseDir(token.NewFileSet(), ".", func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, f := range pkgs["money"].Files {
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || !fn.Name.IsExported() {
+				continue
+			}
+			if fn.Recv == nil {
+				names = append(names, fn.Name.Name)
+				continue
+			}
+			recv := fn.Recv.List[0].Type
+			if star, ok := recv.(*ast.StarExpr); ok {
+				recv = star.X
+			}
+			if typ := recv.(*ast.Ident); typ.IsExported() {
+				names = append(names, typ.Name+"."+fn.Name.Name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// testState is a fmt.State for calling Format directly.
+type testState struct{ bytes.Buffer }
+
+func (*testState) Width() (int, bool)     { return 0, false }
+func (*testState) Precision() (int, bool) { return 2, true }
+func (*testState) Flag(int) bool          { return false }
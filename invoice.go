@@ -0,0 +1,169 @@
+package money
+
+import "fmt"
+
+// Invoice is a list of line items with optional discounts, shipping and taxes.
+//
+// All amounts are rounded to DP decimal places, so the totals always reconcile exactly.
+type Invoice struct {
+	Lines []LineItem
+
+	// Discount applies to the subtotal and is distributed across the lines in proportion
+	// to their net amounts, reducing the amount each is taxed on.
+	Discount Discount
+
+	Shipping      Decimal
+	ShippingTaxes []Tax // taxes levied on shipping, if any
+
+	DP       int          // decimal places amounts are rounded to, e.g. 2 for cents
+	Rounding RoundingMode // rounding mode used for all amounts
+	TaxLevel TaxRounding  // whether tax is rounded per line or per invoice
+}
+
+// LineItem is a single line on an invoice.
+type LineItem struct {
+	Description string
+	Quantity    Decimal
+	UnitPrice   Decimal
+	Discount    Discount
+	Taxes       []Tax // the tax category of the line, e.g. standard rate VAT
+}
+
+// Discount is a percentage discount, a fixed discount, or both, in which case
+// the percentage is applied first.
+//
+// The discount is limited to the amount being discounted, and can only reduce it towards zero.
+// A percentage discount on a credit (a negative amount) reduces the credit, and a fixed discount
+// does not apply to it.
+type Discount struct {
+	Percent Decimal // e.g. Pc(10) for 10% off
+	Amount  Decimal // fixed amount off
+}
+
+// InvoiceTotals is the breakdown of an invoice, where
+// Total = Subtotal - Discount + Shipping + the sum of the Taxes amounts.
+type InvoiceTotals struct {
+	Lines    []LineTotal
+	Subtotal Decimal // sum of the line amounts after line discounts
+	Discount Decimal // invoice discount
+	Shipping Decimal
+	Taxes    []TaxTotal // in order of first appearance
+	Total    Decimal
+}
+
+// LineTotal is the breakdown of a single line item.
+type LineTotal struct {
+	Amount          Decimal // quantity * unit price
+	Discount        Decimal // line discount
+	InvoiceDiscount Decimal // share of the invoice discount
+	Net             Decimal // taxable amount after all discounts
+}
+
+// TaxTotal is the total of a single tax rate on an invoice.
+type TaxTotal struct {
+	Tax    Tax
+	Base   Decimal // net amount taxed, including shipping
+	Amount Decimal
+}
+
+// Totals calculates the subtotal, discounts, taxes and total of the invoice.
+func (inv Invoice) Totals() InvoiceTotals {
+	t := InvoiceTotals{Lines: make([]LineTotal, len(inv.Lines)), Subtotal: NewInt(0)}
+
+	nets := make([]Decimal, len(inv.Lines))
+	for i, item := range inv.Lines {
+		amount := inv.round(orZero(item.Quantity).Mul(orZero(item.UnitPrice)))
+		discount := inv.discount(item.Discount, amount)
+		nets[i] = amount.Sub(discount)
+		t.Lines[i] = LineTotal{Amount: amount, Discount: discount, InvoiceDiscount: NewInt(0), Net: nets[i]}
+		t.Subtotal = t.Subtotal.Add(nets[i])
+	}
+
+	t.Discount = inv.discount(inv.Discount, t.Subtotal)
+
+	// Only lines with the same sign as the discount share it, so no line is increased.
+	weights := make([]Decimal, len(nets))
+	for i, net := range nets {
+		if t.Discount.IsNegative() {
+			net = net.Neg()
+		}
+		weights[i] = Max(net, NewInt(0))
+	}
+	if shares := t.Discount.Allocate(weights...); shares != nil {
+		for i, share := range shares {
+			t.Lines[i].InvoiceDiscount = share
+			t.Lines[i].Net = t.Lines[i].Net.Sub(share)
+		}
+	}
+
+	t.Shipping = inv.round(orZero(inv.Shipping))
+	t.Taxes = inv.taxes(t.Lines, t.Shipping)
+
+	t.Total = t.Subtotal.Sub(t.Discount).Add(t.Shipping)
+	for _, tax := range t.Taxes {
+		t.Total = t.Total.Add(tax.Amount)
+	}
+	return t
+}
+
+// taxes groups the lines and shipping by tax category and totals each tax rate.
+func (inv Invoice) taxes(lines []LineTotal, shipping Decimal) []TaxTotal {
+	var categories [][]Tax
+	bases := map[string][]Decimal{}
+	add := func(taxes []Tax, base Decimal) {
+		if len(taxes) == 0 {
+			return
+		}
+		key := taxKey(taxes...)
+		if _, ok := bases[key]; !ok {
+			categories = append(categories, taxes)
+		}
+		bases[key] = append(bases[key], base)
+	}
+	for i, item := range inv.Lines {
+		add(item.Taxes, lines[i].Net)
+	}
+	add(inv.ShippingTaxes, shipping)
+
+	policy := TaxPolicy{DP: inv.DP, Rounding: inv.Rounding, Level: inv.TaxLevel}
+	var totals []TaxTotal
+	index := map[string]int{}
+	for _, taxes := range categories {
+		r := policy.AddTax(bases[taxKey(taxes...)], taxes...)
+		for j, tax := range taxes {
+			key := taxKey(tax)
+			i, ok := index[key]
+			if !ok {
+				i = len(totals)
+				index[key] = i
+				totals = append(totals, TaxTotal{Tax: tax, Base: NewInt(0), Amount: NewInt(0)})
+			}
+			totals[i].Base = totals[i].Base.Add(r.Net)
+			totals[i].Amount = totals[i].Amount.Add(r.Taxes[j])
+		}
+	}
+	return totals
+}
+
+// discount calculates a discount on an amount, limited to reducing the amount to zero.
+func (inv Invoice) discount(d Discount, amount Decimal) Decimal {
+	discount := inv.round(amount.Mul(orZero(d.Percent))).Add(inv.round(orZero(d.Amount)))
+	zero := NewInt(0)
+	return discount.Clamp(Min(amount, zero), Max(amount, zero))
+}
+
+// round rounds to DP decimal places, with a scale of exactly DP so that the invoice discount
+// is allocated in units of the last decimal place.
+func (inv Invoice) round(d Decimal) Decimal {
+	return quantize(d, inv.DP, inv.Rounding)
+}
+
+// taxKey identifies taxes with the same name, rate and compounding.
+func taxKey(taxes ...Tax) string {
+	var key string
+	for _, t := range taxes {
+		rate := zero().Copy(orZero(t.Rate).value).Reduce()
+		key += fmt.Sprintf("%s\x00%s\x00%t\x00", t.Name, rate, t.Compound)
+	}
+	return key
+}
@@ -0,0 +1,178 @@
+package money
+
+import (
+	"fmt"
+	"testing"
+)
+
+var reducedVAT = Tax{Name: "VAT", Rate: Pc(5)}
+
+func ExampleInvoice_Totals() {
+	inv := Invoice{
+		Lines: []LineItem{
+			{Description: "Widget", Quantity: NewInt(3), UnitPrice: NewCents(999), Discount: Discount{Percent: Pc(10)}, Taxes: []Tax{vat}},
+			{Description: "Gadget", Quantity: NewInt(1), UnitPrice: New(50), Discount: Discount{Amount: New(5)}, Taxes: []Tax{vat}},
+			{Description: "Book", Quantity: NewInt(2), UnitPrice: NewCents(1250), Taxes: []Tax{reducedVAT}},
+		},
+		Discount:      Discount{Amount: New(10)},
+		Shipping:      NewCents(499),
+		ShippingTaxes: []Tax{vat},
+		DP:            2,
+	}
+	t := inv.Totals()
+	fmt.Println("Subtotal", t.Subtotal)
+	fmt.Println("Discount", t.Discount)
+	fmt.Println("Shipping", t.Shipping)
+	for _, tax := range t.Taxes {
+		fmt.Printf("%s %v%% on %v: %v\n", tax.Tax.Name, tax.Tax.Rate.Mul(NewInt(100)), tax.Base, tax.Amount)
+	}
+	fmt.Println("Total", t.Total)
+	// Output:
+	// Subtotal 96.97
+	// Discount 10.00
+	// Shipping 4.99
+	// VAT 20% on 69.54: 13.91
+	// VAT 5% on 22.42: 1.12
+	// Total 106.99
+}
+
+func TestInvoice_Lines(t *testing.T) {
+	inv := Invoice{
+		Lines: []LineItem{
+			{Quantity: NewInt(3), UnitPrice: NewCents(999), Discount: Discount{Percent: Pc(10)}},
+			{Quantity: NewInt(1), UnitPrice: New(50), Discount: Discount{Amount: New(5)}},
+			{Quantity: NewInt(2), UnitPrice: NewCents(1250)},
+			{Quantity: NewScalar(15, 1), UnitPrice: NewCents(333), Discount: Discount{Amount: New(10)}},
+			{Quantity: NewInt(-1), UnitPrice: New(20), Discount: Discount{Amount: New(5)}},
+		},
+		Discount: Discount{Amount: NewInt(10)},
+		DP:       2,
+	}
+	want := []string{
+		"29.97 3.00 2.78 24.19",
+		"50.00 5.00 4.64 40.36",
+		"25.00 0 2.58 22.42",
+		"5.00 5.00 0 0",
+		"-20.00 0 0 -20.00",
+	}
+	for i, l := range inv.Totals().Lines {
+		if got := fmt.Sprintf("%v %v %v %v", l.Amount, l.Discount, l.InvoiceDiscount, l.Net); got != want[i] {
+			t.Errorf("line %d: wanted %s, got %s", i, want[i], got)
+		}
+	}
+}
+
+func TestInvoice_Totals(t *testing.T) {
+	lines := func(taxes ...Tax) []LineItem {
+		return []LineItem{
+			{Quantity: NewInt(1), UnitPrice: NewCents(99), Taxes: taxes},
+			{Quantity: NewInt(1), UnitPrice: NewCents(99), Taxes: taxes},
+			{Quantity: NewInt(1), UnitPrice: NewCents(99), Taxes: taxes},
+		}
+	}
+	for _, tc := range []struct {
+		name string
+		inv  Invoice
+		want string
+	}{
+		{"empty", Invoice{DP: 2}, "0 0 0 [] 0"},
+		{"per line", Invoice{Lines: lines(vat), DP: 2}, "2.97 0 0 [VAT 2.97 0.60] 3.57"},
+		{"per invoice", Invoice{Lines: lines(vat), DP: 2, TaxLevel: PerInvoice}, "2.97 0 0 [VAT 2.97 0.59] 3.56"},
+		{"untaxed", Invoice{Lines: lines(), Shipping: New(1), DP: 2}, "2.97 0 1.00 [] 3.97"},
+		{"percent discount", Invoice{Lines: lines(vat), Discount: Discount{Percent: Pc(50)}, DP: 2}, "2.97 1.48 0 [VAT 1.49 0.30] 1.79"},
+		{"discount exceeds subtotal", Invoice{Lines: lines(vat), Discount: Discount{Amount: New(5)}, DP: 2}, "2.97 2.97 0 [VAT 0 0] 0"},
+		{"whole units", Invoice{Lines: lines(vat), DP: 0}, "3 0 0 [VAT 3 0] 3"},
+		{"compound", Invoice{Lines: lines(gst, Tax{Name: "PST", Rate: Pc(10), Compound: true}), DP: 2}, "2.97 0 0 [GST 2.97 0.15 PST 2.97 0.30] 3.42"},
+		{"credit line", Invoice{
+			Lines: []LineItem{
+				{Quantity: NewInt(1), UnitPrice: New(100), Discount: Discount{Amount: New(10)}, Taxes: []Tax{vat}},
+				{Quantity: NewInt(1), UnitPrice: New(-20), Discount: Discount{Amount: New(10)}, Taxes: []Tax{vat}},
+			},
+			DP: 2,
+		}, "70.00 0 0 [VAT 70.00 14.00] 84.00"},
+		{"percent discount on credit line", Invoice{
+			Lines: []LineItem{
+				{Quantity: NewInt(1), UnitPrice: New(100), Taxes: []Tax{vat}},
+				{Quantity: NewInt(1), UnitPrice: New(-20), Discount: Discount{Percent: Pc(10)}, Taxes: []Tax{vat}},
+			},
+			DP: 2,
+		}, "82.00 0 0 [VAT 82.00 16.40] 98.40"},
+		{"invoice discount with credit line", Invoice{
+			Lines: []LineItem{
+				{Quantity: NewInt(1), UnitPrice: New(100), Taxes: []Tax{vat}},
+				{Quantity: NewInt(1), UnitPrice: New(-20), Taxes: []Tax{vat}},
+			},
+			Discount: Discount{Amount: New(10)},
+			DP:       2,
+		}, "80.00 10.00 0 [VAT 70.00 14.00] 84.00"},
+		{"invoice discount on credit note", Invoice{
+			Lines: []LineItem{
+				{Quantity: NewInt(1), UnitPrice: New(-100), Taxes: []Tax{vat}},
+				{Quantity: NewInt(1), UnitPrice: New(20), Taxes: []Tax{vat}},
+			},
+			Discount: Discount{Percent: Pc(10)},
+			DP:       2,
+		}, "-80.00 -8.00 0 [VAT -72.00 -14.40] -86.40"},
+		{"quebec and ontario", Invoice{
+			Lines: []LineItem{
+				{Quantity: NewInt(1), UnitPrice: New(100), Taxes: []Tax{gst, qst}},
+				{Quantity: NewInt(1), UnitPrice: New(100), Taxes: []Tax{gst}},
+			},
+			DP: 2,
+		}, "200.00 0 0 [GST 200.00 10.00 QST 100.00 9.98] 219.98"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.inv.Totals()
+			var taxes []string
+			for _, tax := range r.Taxes {
+				taxes = append(taxes, fmt.Sprintf("%s %v %v", tax.Tax.Name, tax.Base, tax.Amount))
+			}
+			got := fmt.Sprintf("%v %v %v %v %v", r.Subtotal, r.Discount, r.Shipping, taxes, r.Total)
+			if got != tc.want {
+				t.Errorf("wanted %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestInvoice_Reconciles(t *testing.T) {
+	for _, level := range []TaxRounding{PerLine, PerInvoice} {
+		for n := int64(1); n <= 50; n++ {
+			inv := Invoice{Discount: Discount{Percent: Pc(n % 7), Amount: NewCents(n * 3)}, Shipping: NewCents(n * 11), ShippingTaxes: []Tax{vat}, DP: 2, TaxLevel: level}
+			for i := int64(0); i < n%9+1; i++ {
+				inv.Lines = append(inv.Lines, LineItem{
+					Quantity:  NewScalar(i*n%13+1, 1),
+					UnitPrice: NewCents(i*n*37%1000 + 1),
+					Discount:  Discount{Percent: Pc(i % 3 * 5)},
+					Taxes:     [][]Tax{{vat}, {reducedVAT}, {gst, qst}}[i%3],
+				})
+			}
+			r := inv.Totals()
+
+			subtotal, discount, bases := NewInt(0), NewInt(0), NewInt(0)
+			for _, l := range r.Lines {
+				subtotal = subtotal.Add(l.Amount).Sub(l.Discount)
+				discount = discount.Add(l.InvoiceDiscount)
+				if !l.Amount.Sub(l.Discount).Sub(l.InvoiceDiscount).Equals(l.Net) {
+					t.Errorf("%d: line does not reconcile: %+v", n, l)
+				}
+			}
+			total := r.Subtotal.Sub(r.Discount).Add(r.Shipping)
+			for _, tax := range r.Taxes {
+				if tax.Tax.Rate.Equals(vat.Rate) || tax.Tax.Rate.Equals(reducedVAT.Rate) || tax.Tax.Name == "GST" {
+					bases = bases.Add(tax.Base)
+				}
+				total = total.Add(tax.Amount)
+			}
+			if !subtotal.Equals(r.Subtotal) || !discount.Equals(r.Discount) {
+				t.Errorf("%d: lines do not reconcile: %v %v, %v %v", n, subtotal, r.Subtotal, discount, r.Discount)
+			}
+			if !bases.Equals(r.Subtotal.Sub(r.Discount).Add(r.Shipping)) {
+				t.Errorf("%d: tax bases %v do not reconcile", n, bases)
+			}
+			if !total.Equals(r.Total) {
+				t.Errorf("%d: wanted total %v, got %v", n, total, r.Total)
+			}
+		}
+	}
+}
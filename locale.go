@@ -0,0 +1,104 @@
+package money
+
+import (
+	"sort"
+	"strings"
+)
+
+// Locale describes how numbers and currency amounts are written in a locale,
+// following the conventions of the Unicode CLDR.
+//
+// The zero Locale writes plain numbers with a '.' decimal mark and no grouping.
+type Locale struct {
+	Tag     string // BCP 47 language tag e.g. "en-US"
+	Decimal string // decimal mark e.g. "."
+	Group   string // grouping separator e.g. ","
+	Minus   string // minus sign, "-" if empty
+
+	// Grouping is the size of each group of integer digits from the decimal mark outwards,
+	// where the last size repeats e.g. {3} for 1,234,567 or {3, 2} for 12,34,567.
+	Grouping []int
+
+	// MinimumGroupingDigits is the number of digits required before the first separator for
+	// grouping to be used, e.g. 2 writes 1234 but 12.345. Zero is the same as 1.
+	MinimumGroupingDigits int
+
+	SymbolFirst   bool          // whether the currency symbol precedes the amount
+	SymbolSpacing string        // between the currency symbol and the amount, if any
+	MinusPosition MinusPosition // where the minus sign goes in a negative amount with a preceding symbol
+}
+
+// MinusPosition is the position of the minus sign in a negative amount with a preceding currency
+// symbol, as in the locale's CLDR negative currency pattern.
+type MinusPosition int
+
+// Minus sign positions.
+const (
+	// MinusFirst writes the minus sign before the symbol, e.g. -$1.00.
+	MinusFirst MinusPosition = iota
+	// MinusAfterSymbol writes the minus sign between the symbol and its spacing and the number, e.g. € -1,00.
+	MinusAfterSymbol
+	// MinusForSpacing writes the minus sign between the symbol and the number in place of the spacing, e.g. CHF-1.00.
+	MinusForSpacing
+)
+
+// LookupLocale finds a locale in the embedded table by its language tag, e.g. "en-US" or "en_US".
+//
+// Locales not in the table can be declared directly as Locale values.
+func LookupLocale(tag string) (Locale, bool) {
+	l, ok := locales[strings.Replace(tag, "_", "-", -1)]
+	return l, ok
+}
+
+// MustLocale is like LookupLocale but panics if the locale is not found.
+func MustLocale(tag string) Locale {
+	l, ok := LookupLocale(tag)
+	if !ok {
+		panic("money: unknown locale " + tag)
+	}
+	return l
+}
+
+// Locales returns the tags of all locales in the embedded table.
+func Locales() []string {
+	tags := make([]string, 0, len(locales))
+	for tag := range locales {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+const (
+	nbsp       = "\u00a0" // no-break space
+	narrowNBSP = "\u202f" // narrow no-break space
+)
+
+var locales = map[string]Locale{}
+
+func init() {
+	thousands, indian := []int{3}, []int{3, 2}
+	for _, l := range []Locale{
+		{Tag: "de-CH", Decimal: ".", Group: "’", Grouping: thousands, SymbolFirst: true, SymbolSpacing: nbsp, MinusPosition: MinusForSpacing},
+		{Tag: "de-DE", Decimal: ",", Group: ".", Grouping: thousands, SymbolSpacing: nbsp},
+		{Tag: "en-AU", Decimal: ".", Group: ",", Grouping: thousands, SymbolFirst: true},
+		{Tag: "en-CA", Decimal: ".", Group: ",", Grouping: thousands, SymbolFirst: true},
+		{Tag: "en-GB", Decimal: ".", Group: ",", Grouping: thousands, SymbolFirst: true},
+		{Tag: "en-IN", Decimal: ".", Group: ",", Grouping: indian, SymbolFirst: true},
+		{Tag: "en-US", Decimal: ".", Group: ",", Grouping: thousands, SymbolFirst: true},
+		{Tag: "es-ES", Decimal: ",", Group: ".", Grouping: thousands, MinimumGroupingDigits: 2, SymbolSpacing: nbsp},
+		{Tag: "es-MX", Decimal: ".", Group: ",", Grouping: thousands, SymbolFirst: true},
+		{Tag: "fr-CA", Decimal: ",", Group: nbsp, Grouping: thousands, SymbolSpacing: nbsp},
+		{Tag: "fr-FR", Decimal: ",", Group: narrowNBSP, Grouping: thousands, SymbolSpacing: nbsp},
+		{Tag: "hi-IN", Decimal: ".", Group: ",", Grouping: indian, SymbolFirst: true},
+		{Tag: "it-IT", Decimal: ",", Group: ".", Grouping: thousands, SymbolSpacing: nbsp},
+		{Tag: "ja-JP", Decimal: ".", Group: ",", Grouping: thousands, SymbolFirst: true},
+		{Tag: "nl-NL", Decimal: ",", Group: ".", Grouping: thousands, SymbolFirst: true, SymbolSpacing: nbsp, MinusPosition: MinusAfterSymbol},
+		{Tag: "pl-PL", Decimal: ",", Group: nbsp, Grouping: thousands, MinimumGroupingDigits: 2, SymbolSpacing: nbsp},
+		{Tag: "pt-BR", Decimal: ",", Group: ".", Grouping: thousands, SymbolFirst: true, SymbolSpacing: nbsp},
+		{Tag: "sv-SE", Decimal: ",", Group: nbsp, Minus: "−", Grouping: thousands, SymbolSpacing: nbsp},
+		{Tag: "zh-CN", Decimal: ".", Group: ",", Grouping: thousands, SymbolFirst: true},
+	} {
+		locales[l.Tag] = l
+	}
+}
@@ -0,0 +1,24 @@
+package money
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleLookupLocale() {
+	l, ok := LookupLocale("en_IN")
+	fmt.Println(l.Tag, l.Grouping, ok)
+	// Output: en-IN [3 2] true
+}
+
+func TestLocaleTable(t *testing.T) {
+	for _, tag := range Locales() {
+		l := MustLocale(tag)
+		if l.Tag != tag || l.Decimal == "" || l.Group == "" || l.Decimal == l.Group || len(l.Grouping) == 0 {
+			t.Errorf("invalid locale %+v", l)
+		}
+	}
+	if _, ok := LookupLocale("xx-XX"); ok {
+		t.Error("unexpected locale xx-XX")
+	}
+}
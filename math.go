@@ -1,14 +1,11 @@
 package money
 
-import (
-	"math/big"
-
-	"github.com/ericlagergren/decimal/math"
-)
+import "math/big"
 
 // RoundDP rounds the decimal to the specified number of decimal places.
 func (d Decimal) RoundDP(dp int, mode RoundingMode) Decimal {
-	sigfigs := d.value.Precision() - d.value.Scale() + dp
+	v := d.val()
+	sigfigs := v.Precision() - v.Scale() + dp
 	if sigfigs < 0 {
 		return d
 	}
@@ -17,34 +14,88 @@ func (d Decimal) RoundDP(dp int, mode RoundingMode) Decimal {
 
 // Round rounds the decimal to the specified number of significant figures.
 func (d Decimal) Round(sigfigs int, mode RoundingMode) Decimal {
-	r := zero().Copy(d.value)
+	v := d.val()
+	r := zero().Copy(v)
 
 	r.Context.RoundingMode = mode
 	r.Round(sigfigs)
-	r.Context.RoundingMode = d.value.Context.RoundingMode
+	r.Context.RoundingMode = v.Context.RoundingMode
 
 	return wrap(r)
 }
 
+// RoundTo rounds the decimal to a multiple of increment, e.g. 1.23 rounded to 0.05 is 1.25.
+// The result has the scale of the increment.
+//
+// The increment must be positive and finite, otherwise the decimal is returned unchanged,
+// as are NaN and infinite values.
+func (d Decimal) RoundTo(increment Decimal, mode RoundingMode) Decimal {
+	v, inc := d.val(), increment.val()
+	if !v.IsFinite() || !inc.IsFinite() || !increment.IsPositive() {
+		return d
+	}
+
+	// Divide in whole units of the finer of the two scales.
+	scale := v.Scale()
+	if inc.Scale() > scale {
+		scale = inc.Scale()
+	}
+	n := mantissa(v)
+	n.Mul(n, pow10(scale-v.Scale()))
+	units := mantissa(inc)
+	multiple := roundQuo(n, new(big.Int).Mul(units, pow10(scale-inc.Scale())), mode)
+	return wrap(zero().SetBigMantScale(multiple.Mul(multiple, units), inc.Scale()))
+}
+
+// roundQuo calculates n/d rounded to an integer, where d is positive.
+func roundQuo(n, d *big.Int, mode RoundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(n, d, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+	half := r.Abs(r).Lsh(r, 1).Cmp(d) // compares the remainder with d/2
+
+	var away bool
+	switch mode {
+	case ToNearestEven:
+		away = half > 0 || (half == 0 && q.Bit(0) == 1)
+	case ToNearestAway:
+		away = half >= 0
+	case AwayFromZero:
+		away = true
+	case ToNegativeInf:
+		away = n.Sign() < 0
+	case ToPositiveInf:
+		away = n.Sign() > 0
+	}
+	if away {
+		q.Add(q, big.NewInt(int64(n.Sign())))
+	}
+	return q
+}
+
+// pow10 calculates 10^n.
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
 // PowInt calculates d^i.
 func (d Decimal) PowInt(i int) Decimal {
-	n := zero().SetUint64(uint64(i))
-	return wrap(math.Pow(zero(), d.value, n))
+	return defaultContext.PowInt(d, i)
 }
 
 // Pow calculates d^n.
 func (d Decimal) Pow(n Decimal) Decimal {
-	return wrap(math.Pow(zero(), d.value, n.value))
+	return defaultContext.Pow(d, n)
 }
 
 // PowFrac calculates d^(num/denom).
 func (d Decimal) PowFrac(num, denom int) Decimal {
-	r := big.NewRat(int64(num), int64(denom))
-	n := zero().SetRat(r)
-	return wrap(math.Pow(zero(), d.value, n))
+	return defaultContext.PowFrac(d, num, denom)
 }
 
 // Max returns the value closest to positive infinity.
+// NaN values are ignored, unless first is NaN in which case it is returned.
 func Max(first Decimal, others ...Decimal) Decimal {
 	for _, d := range others {
 		if first.LessThan(d) {
@@ -53,3 +104,31 @@ func Max(first Decimal, others ...Decimal) Decimal {
 	}
 	return first
 }
+
+// Min returns the value closest to negative infinity.
+// NaN values are ignored, unless first is NaN in which case it is returned.
+func Min(first Decimal, others ...Decimal) Decimal {
+	for _, d := range others {
+		if d.LessThan(first) {
+			first = d
+		}
+	}
+	return first
+}
+
+// Clamp limits the decimal to the range [min, max], returning min if it is less than min
+// or max if it is greater than max. NaN is returned unchanged.
+func (d Decimal) Clamp(min, max Decimal) Decimal {
+	switch {
+	case d.LessThan(min):
+		return min
+	case d.GreaterThan(max):
+		return max
+	}
+	return d
+}
+
+// Log calculates the natural logarithm of d.
+func (d Decimal) Log() Decimal {
+	return defaultContext.Log(d)
+}
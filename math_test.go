@@ -35,6 +35,42 @@ func ExampleMax() {
 	// Output: 2.00
 }
 
+func ExampleMin() {
+	fmt.Print(Min(New(1), NewCents(-200), NewInt(1)))
+	// Output: -2.00
+}
+
+func ExampleDecimal_Clamp() {
+	fmt.Println(Pc(150).Clamp(NewInt(0), NewInt(1)))
+	fmt.Println(Pc(-5).Clamp(NewInt(0), NewInt(1)))
+	fmt.Println(Pc(50).Clamp(NewInt(0), NewInt(1)))
+	// Output:
+	// 1
+	// 0
+	// 0.5
+}
+
+func TestMinMax(t *testing.T) {
+	nan := wrap(zero().SetNaN(false))
+	for _, tc := range []struct {
+		ds       []Decimal
+		min, max string
+	}{
+		{[]Decimal{NewInt(1)}, "1", "1"},
+		{[]Decimal{Decimal{}, NewInt(1), NewInt(-1)}, "-1", "1"},
+		{[]Decimal{NewInt(1), nan, NewInt(2)}, "1", "2"},
+		{[]Decimal{nan, NewInt(1)}, "NaN", "NaN"},
+	} {
+		min, max := Min(tc.ds[0], tc.ds[1:]...), Max(tc.ds[0], tc.ds[1:]...)
+		if fmt.Sprint(min) != tc.min || fmt.Sprint(max) != tc.max {
+			t.Errorf("%v: wanted %s %s, got %v %v", tc.ds, tc.min, tc.max, min, max)
+		}
+	}
+	if got := nan.Clamp(NewInt(0), NewInt(1)); fmt.Sprint(got) != "NaN" {
+		t.Errorf("clamp NaN: got %v", got)
+	}
+}
+
 func TestDecimal_RoundDP(t *testing.T) {
 	for n, tc := range []struct {
 		d    Decimal
@@ -44,6 +80,7 @@ func TestDecimal_RoundDP(t *testing.T) {
 	}{
 		{NewScalar(1, -5), 2, ToNearestEven, NewScalar(1, -5)},
 		{NewScalar(1, 5), 2, ToNearestEven, NewScalar(1, 5)},
+		{Decimal{}, 2, ToZero, NewInt(0)},
 	} {
 		got := tc.d.RoundDP(tc.dp, tc.mode)
 		if !got.Equals(tc.w) {
@@ -51,3 +88,74 @@ func TestDecimal_RoundDP(t *testing.T) {
 		}
 	}
 }
+
+func TestDecimal_Round(t *testing.T) {
+	for n, tc := range []struct {
+		d       Decimal
+		sigfigs int
+		mode    RoundingMode
+		w       Decimal
+	}{
+		{NewCents(12345), 3, ToNearestEven, New(123)},
+		{NewCents(12355), 4, ToNearestEven, NewScalar(1236, 1)},
+		{NewCents(-12345), 3, ToNegativeInf, New(-124)},
+		{Decimal{}, 2, ToZero, NewInt(0)},
+	} {
+		got := tc.d.Round(tc.sigfigs, tc.mode)
+		if !got.Equals(tc.w) {
+			t.Errorf("#%d wanted %v, got %v", n, tc.w, got)
+		}
+	}
+}
+
+func ExampleDecimal_RoundTo() {
+	fmt.Println(NewCents(123).RoundTo(NewCents(5), ToNearestEven))
+	fmt.Println(NewCents(1234).RoundTo(NewScalar(25, 2), ToPositiveInf))
+	// Output:
+	// 1.25
+	// 12.50
+}
+
+func TestDecimal_RoundTo(t *testing.T) {
+	for n, tc := range []struct {
+		d, inc Decimal
+		mode   RoundingMode
+		w      string
+	}{
+		{NewCents(122), NewCents(5), ToNearestEven, "1.20"},
+		{NewCents(123), NewCents(5), ToNearestEven, "1.25"},
+		{NewScalar(1225, 3), NewCents(5), ToNearestEven, "1.20"},
+		{NewScalar(1225, 3), NewCents(5), ToNearestAway, "1.25"},
+		{NewScalar(-1225, 3), NewCents(5), ToNearestAway, "-1.25"},
+		{NewScalar(-1225, 3), NewCents(5), ToNegativeInf, "-1.25"},
+		{NewScalar(-1225, 3), NewCents(5), ToZero, "-1.20"},
+		{NewCents(1201), NewCents(5), ToPositiveInf, "12.05"},
+		{NewCents(1250), NewInt(1), ToNearestEven, "12"},
+		{NewCents(1350), NewInt(1), ToNearestEven, "14"},
+		{NewCents(1250), NewCents(100), ToNearestAway, "13.00"},
+		{New(1234), NewInt(500), ToNearestEven, "1000"},
+		{NewCents(2), NewCents(5), ToNearestEven, "0"},
+		{NewCents(3), NewCents(5), ToNearestEven, "0.05"},
+		{NewCents(1), NewScalar(1, 1), ToPositiveInf, "0.1"},
+		{NewCents(100), NewScalar(3, 1), ToNearestEven, "0.9"},
+		{NewCents(123), NewInt(0), ToNearestEven, "1.23"},
+		{NewCents(123), NewCents(-5), ToNearestEven, "1.23"},
+		{NewCents(123), Decimal{}, ToNearestEven, "1.23"},
+		{NewCents(123), wrap(zero().SetInf(false)), ToNearestEven, "1.23"},
+		{Decimal{}, NewCents(5), ToNearestEven, "0"},
+		{wrap(zero().SetNaN(false)), NewCents(5), ToNearestEven, "NaN"},
+		{NewCents(-3), NewScalar(5, -3), ToNegativeInf, "-5000"},
+		{MustParse("1e40"), NewCents(5), ToNearestEven, "10000000000000000000000000000000000000000.00"},
+		{MustParse("1234567890123456789012345678901234567890.125"), NewCents(5), ToNearestEven, "1234567890123456789012345678901234567890.10"},
+		{MustParse("1234567890123456789012345678901234567890.125"), NewCents(5), ToNearestAway, "1234567890123456789012345678901234567890.15"},
+	} {
+		if got := tc.d.RoundTo(tc.inc, tc.mode); fmt.Sprint(got) != tc.w {
+			t.Errorf("#%d wanted %s, got %v", n, tc.w, got)
+		}
+	}
+}
+
+func ExampleDecimal_Log() {
+	fmt.Print(New(10).Log().RoundDP(4, ToNearestEven))
+	// Output: 2.3026
+}
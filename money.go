@@ -0,0 +1,102 @@
+package money
+
+import "fmt"
+
+// Money is an immutable decimal amount in a particular currency.
+//
+// Arithmetic and comparisons between amounts of different currencies
+// return a *CurrencyMismatchError rather than silently mixing them.
+type Money struct {
+	amount   Decimal
+	currency Currency
+}
+
+// CurrencyMismatchError is returned when combining amounts of different currencies.
+type CurrencyMismatchError struct {
+	A, B Currency
+}
+
+func (e *CurrencyMismatchError) Error() string {
+	return fmt.Sprintf("money: currency mismatch %s and %s", e.A.Code, e.B.Code)
+}
+
+// NewMoney creates an amount of the given currency.
+func NewMoney(amount Decimal, currency Currency) Money {
+	return Money{amount, currency}
+}
+
+// NewMinor creates an amount from a count of the currency's minor units
+// e.g. NewMinor(1234, MustCurrency("KWD")) = 1.234 KWD.
+func NewMinor(minor int64, currency Currency) Money {
+	return Money{wrap(zero().SetMantScale(minor, currency.MinorUnits)), currency}
+}
+
+// Amount returns the decimal amount.
+func (m Money) Amount() Decimal { return m.amount }
+
+// Currency returns the currency of the amount.
+func (m Money) Currency() Currency { return m.currency }
+
+// String formats the amount to the currency's minor units followed by its code e.g. "12.34 GBP".
+func (m Money) String() string {
+	return fmt.Sprintf("%.*f %s", m.currency.MinorUnits, m.amount, m.currency.Code)
+}
+
+// Add calculates m + o.
+func (m Money) Add(o Money) (Money, error) {
+	if err := m.check(o); err != nil {
+		return Money{}, err
+	}
+	return Money{m.amount.Add(o.amount), m.currency}, nil
+}
+
+// Sub calculates m - o.
+func (m Money) Sub(o Money) (Money, error) {
+	if err := m.check(o); err != nil {
+		return Money{}, err
+	}
+	return Money{m.amount.Sub(o.amount), m.currency}, nil
+}
+
+// Mul calculates m * d.
+func (m Money) Mul(d Decimal) Money {
+	return Money{m.amount.Mul(d), m.currency}
+}
+
+// Div calculates m / d.
+func (m Money) Div(d Decimal) Money {
+	return Money{m.amount.Div(d), m.currency}
+}
+
+// Round rounds the amount to the currency's minor units.
+func (m Money) Round(mode RoundingMode) Money {
+	return Money{quantize(m.amount, m.currency.MinorUnits, mode), m.currency}
+}
+
+// RoundCash rounds the amount to the currency's cash increment, e.g. to 0.05 for CHF.
+func (m Money) RoundCash(mode RoundingMode) Money {
+	return Money{m.amount.RoundTo(m.currency.CashIncrement(), mode), m.currency}
+}
+
+// Equals returns true if both amounts are the same currency and value.
+func (m Money) Equals(o Money) (bool, error) {
+	if err := m.check(o); err != nil {
+		return false, err
+	}
+	return m.amount.Equals(o.amount), nil
+}
+
+// LessThan returns true if the receiver is less than the argument.
+func (m Money) LessThan(o Money) (bool, error) {
+	if err := m.check(o); err != nil {
+		return false, err
+	}
+	return m.amount.LessThan(o.amount), nil
+}
+
+func (m Money) check(o Money) error {
+	if m.currency.Code != o.currency.Code {
+		return &CurrencyMismatchError{m.currency, o.currency}
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package money
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleNewMinor() {
+	fmt.Println(NewMinor(1234, MustCurrency("GBP")))
+	fmt.Println(NewMinor(1234, MustCurrency("JPY")))
+	fmt.Println(NewMinor(1234, MustCurrency("KWD")))
+	// Output:
+	// 12.34 GBP
+	// 1234 JPY
+	// 1.234 KWD
+}
+
+func ExampleMoney_Add() {
+	a := NewMoney(New(5), MustCurrency("USD"))
+	b := NewMoney(NewCents(250), MustCurrency("USD"))
+	c := NewMoney(New(1), MustCurrency("EUR"))
+
+	sum, err := a.Add(b)
+	fmt.Println(sum, err)
+
+	_, err = a.Add(c)
+	fmt.Println(err)
+
+	// Output:
+	// 7.50 USD <nil>
+	// money: currency mismatch USD and EUR
+}
+
+func ExampleMoney_RoundCash() {
+	fmt.Println(NewMoney(NewCents(1234), MustCurrency("CHF")).RoundCash(ToNearestAway))
+	fmt.Println(NewMoney(NewCents(1250), MustCurrency("SEK")).RoundCash(ToNearestAway))
+	fmt.Println(NewMoney(NewCents(1234), MustCurrency("GBP")).RoundCash(ToNearestAway))
+	// Output:
+	// 12.35 CHF
+	// 13.00 SEK
+	// 12.34 GBP
+}
+
+func TestMoney(t *testing.T) {
+	gbp, eur := MustCurrency("GBP"), MustCurrency("EUR")
+	btc := Currency{Code: "XBT", MinorUnits: 8, Symbol: "₿"}
+
+	a := NewMoney(NewCents(1050), gbp)
+	b := NewMinor(25, gbp)
+
+	if sum, err := a.Add(b); err != nil || !sum.Amount().Equals(NewCents(1075)) || sum.Currency() != gbp {
+		t.Errorf("add: got %v, %v", sum, err)
+	}
+	if diff, err := a.Sub(b); err != nil || !diff.Amount().Equals(NewCents(1025)) {
+		t.Errorf("sub: got %v, %v", diff, err)
+	}
+	if got := a.Mul(Pc(10)).Round(ToNearestEven); got.String() != "1.05 GBP" {
+		t.Errorf("mul: got %v", got)
+	}
+	if got := a.Div(NewInt(3)).Round(ToNearestEven); got.String() != "3.50 GBP" {
+		t.Errorf("div: got %v", got)
+	}
+	if got := NewMinor(1, btc); got.String() != "0.00000001 XBT" {
+		t.Errorf("minor units: got %v", got)
+	}
+	if lt, err := b.LessThan(a); err != nil || !lt {
+		t.Errorf("less than: got %t, %v", lt, err)
+	}
+	if eq, err := a.Equals(NewMoney(New(1050).Div(New(100)), gbp)); err != nil || !eq {
+		t.Errorf("equals: got %t, %v", eq, err)
+	}
+
+	c := NewMoney(New(1), eur)
+	for name, f := range map[string]func() error{
+		"add":      func() error { _, err := a.Add(c); return err },
+		"sub":      func() error { _, err := a.Sub(c); return err },
+		"equals":   func() error { _, err := a.Equals(c); return err },
+		"lessthan": func() error { _, err := a.LessThan(c); return err },
+	} {
+		err, ok := f().(*CurrencyMismatchError)
+		if !ok || err.A != gbp || err.B != eur {
+			t.Errorf("%s: wanted currency mismatch, got %v", name, err)
+		}
+	}
+}
+
+func TestMoney_Round(t *testing.T) {
+	for _, tc := range []struct {
+		m    Money
+		mode RoundingMode
+		want string
+	}{
+		{NewMoney(NewScalar(4, 1), MustCurrency("JPY")), ToNearestEven, "0"},
+		{NewMoney(NewScalar(6, 1), MustCurrency("JPY")), ToNearestEven, "1"},
+		{NewMoney(NewScalar(6, 3), MustCurrency("GBP")), ToNearestEven, "0.01"},
+		{NewMoney(NewScalar(4, 3), MustCurrency("GBP")), ToNearestEven, "0"},
+		{NewMoney(NewScalar(4, 3), MustCurrency("GBP")), ToPositiveInf, "0.01"},
+		{NewMoney(NewScalar(-4, 3), MustCurrency("GBP")), ToNegativeInf, "-0.01"},
+		{NewMoney(NewScalar(12345, 4), MustCurrency("KWD")), ToNearestEven, "1.234"},
+		{NewMoney(NewInt(5), MustCurrency("GBP")), ToNearestEven, "5.00"},
+	} {
+		if got := fmt.Sprint(tc.m.Round(tc.mode).Amount()); got != tc.want {
+			t.Errorf("%v: wanted %s, got %s", tc.m, tc.want, got)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"unicode/utf8"
+)
+
+// ParseError describes a problem parsing a decimal string.
+type ParseError struct {
+	Input  string // the string being parsed
+	Offset int    // byte offset of the offending character
+	Msg    string // description of the problem
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("money: parsing %q: %s at offset %d", e.Input, e.Msg, e.Offset)
+}
+
+// Parse converts a string to a decimal, preserving the scale of the input.
+//
+// Accepted forms are an optional sign, digits with an optional decimal point,
+// optional comma thousands separators in groups of three, an optional exponent
+// and an optional trailing '%', '‰' or '‱' suffix. The suffixes map onto the same
+// scales as Pc, Pm and Bp respectively, i.e. "2%" = Pc(2) = 0.02.
+//
+// NaN and infinite values are rejected.
+func Parse(s string) (Decimal, error) {
+	p := parser{input: s}
+	return p.parse()
+}
+
+// MustParse is like Parse but panics if the string cannot be parsed.
+// It simplifies the safe initialisation of package level variables.
+func MustParse(s string) Decimal {
+	d, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+var suffixScales = map[rune]int{'%': 2, '‰': 3, '‱': 4}
+
+type parser struct {
+	input  string
+	pos    int
+	digits []byte
+	scale  int
+}
+
+func (p *parser) parse() (Decimal, error) {
+	if p.input == "" {
+		return Decimal{}, p.errorf("empty string")
+	}
+
+	neg := false
+	switch p.input[0] {
+	case '-':
+		neg = true
+		fallthrough
+	case '+':
+		p.pos++
+	}
+
+	if err := p.integer(); err != nil {
+		return Decimal{}, err
+	}
+	if err := p.fraction(); err != nil {
+		return Decimal{}, err
+	}
+	if len(p.digits) == 0 {
+		if p.pos < len(p.input) {
+			return Decimal{}, p.unexpected()
+		}
+		return Decimal{}, p.errorf("no digits")
+	}
+	if err := p.exponent(); err != nil {
+		return Decimal{}, err
+	}
+	p.suffix()
+	if p.pos < len(p.input) {
+		return Decimal{}, p.unexpected()
+	}
+
+	mant, ok := new(big.Int).SetString(string(p.digits), 10)
+	if !ok {
+		return Decimal{}, p.errorf("invalid digits")
+	}
+	if neg {
+		mant.Neg(mant)
+	}
+	return wrap(zero().SetBigMantScale(mant, p.scale)), nil
+}
+
+// integer scans the digits before any decimal point, validating separators.
+func (p *parser) integer() error {
+	group, grouped := 0, false
+	for ; p.pos < len(p.input); p.pos++ {
+		c := p.input[p.pos]
+		switch {
+		case isDigit(c):
+			p.digits = append(p.digits, c)
+			group++
+		case c == ',':
+			if group == 0 || (grouped && group != 3) || (!grouped && group > 3) {
+				return p.errorf("misplaced thousands separator")
+			}
+			group, grouped = 0, true
+		default:
+			if grouped && group != 3 {
+				return p.errorf("misplaced thousands separator")
+			}
+			return nil
+		}
+	}
+	if grouped && group != 3 {
+		return p.errorf("misplaced thousands separator")
+	}
+	return nil
+}
+
+// fraction scans an optional decimal point and the digits following it.
+func (p *parser) fraction() error {
+	if p.pos >= len(p.input) || p.input[p.pos] != '.' {
+		return nil
+	}
+	for p.pos++; p.pos < len(p.input) && isDigit(p.input[p.pos]); p.pos++ {
+		p.digits = append(p.digits, p.input[p.pos])
+		p.scale++
+	}
+	return nil
+}
+
+// exponent scans an optional exponent, adjusting the scale accordingly.
+func (p *parser) exponent() error {
+	if p.pos >= len(p.input) || (p.input[p.pos] != 'e' && p.input[p.pos] != 'E') {
+		return nil
+	}
+	p.pos++
+	start := p.pos
+	if p.pos < len(p.input) && (p.input[p.pos] == '+' || p.input[p.pos] == '-') {
+		p.pos++
+	}
+	digits := p.pos
+	for p.pos < len(p.input) && isDigit(p.input[p.pos]) {
+		p.pos++
+	}
+	if p.pos == digits {
+		return p.errorf("missing exponent digits")
+	}
+	exp, err := strconv.ParseInt(p.input[start:p.pos], 10, 32)
+	if err != nil || exp > maxExponent || exp < -maxExponent {
+		p.pos = start
+		return p.errorf("exponent out of range")
+	}
+	p.scale -= int(exp)
+	return nil
+}
+
+// maxExponent keeps parsed scales well within the range of the underlying context.
+const maxExponent = 6000
+
+// suffix scans an optional percent, permille or permyriad symbol.
+func (p *parser) suffix() {
+	r, size := utf8.DecodeRuneInString(p.input[p.pos:])
+	if scale, ok := suffixScales[r]; ok {
+		p.scale += scale
+		p.pos += size
+	}
+}
+
+func (p *parser) unexpected() error {
+	r, _ := utf8.DecodeRuneInString(p.input[p.pos:])
+	return p.errorf("unexpected %q", r)
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return &ParseError{Input: p.input, Offset: p.pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+func isDigit(c byte) bool { return '0' <= c && c <= '9' }
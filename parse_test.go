@@ -0,0 +1,112 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+func ExampleParse() {
+	d, err := Parse("-1,234.50")
+	fmt.Println(d, err)
+
+	_, err = Parse("12.3.4")
+	fmt.Println(err)
+
+	// Output:
+	// -1234.50 <nil>
+	// money: parsing "12.3.4": unexpected '.' at offset 4
+}
+
+func ExampleMustParse() {
+	fmt.Println(MustParse("2.5%"))
+	// Output: 0.025
+}
+
+func TestParse(t *testing.T) {
+	for _, tc := range []struct {
+		in   string
+		want Decimal
+		fmt  string
+	}{
+		{"0", decr(0, 0), "0"},
+		{"1", NewInt(1), "1"},
+		{"1.00", NewCents(100), "1.00"},
+		{"+12.34", NewCents(1234), "12.34"},
+		{"-12.34", NewCents(-1234), "-12.34"},
+		{".5", half, "0.5"},
+		{"5.", NewInt(5), "5"},
+		{"1,234,567.89", NewCents(123456789), "1234567.89"},
+		{"1e3", NewScalar(1, -3), "1e+3"},
+		{"1.5E-3", NewScalar(15, 4), "0.0015"},
+		{"2%", Pc(2), "0.02"},
+		{"3‰", Pm(3), "0.003"},
+		{"4‱", Bp(4), "0.0004"},
+		{"12.5%", NewScalar(125, 3), "0.125"},
+		{"1e2%", NewInt(1), "1"},
+		{"123456789012345678901234567890.12", bigDecimal("12345678901234567890123456789012", 2), "123456789012345678901234567890.12"},
+	} {
+		t.Run(tc.in, func(t *testing.T) {
+			got, err := Parse(tc.in)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !got.Equals(tc.want) {
+				t.Errorf("wanted %v, got %v", tc.want, got)
+			}
+			if s := fmt.Sprint(got.value); s != tc.fmt {
+				t.Errorf("wanted %s, got %s", tc.fmt, s)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, tc := range []struct {
+		in     string
+		offset int
+	}{
+		{"", 0},
+		{"-", 1},
+		{"+-1", 1},
+		{"abc", 0},
+		{"NaN", 0},
+		{"Inf", 0},
+		{"1.2.3", 3},
+		{"1,23", 4},
+		{"1234,567", 4},
+		{",123", 0},
+		{"1,234,56.7", 8},
+		{"1e", 2},
+		{"1e+", 3},
+		{"1e99999999999", 2},
+		{"1%%", 2},
+		{"1 ", 1},
+		{" 1", 0},
+	} {
+		t.Run(tc.in, func(t *testing.T) {
+			_, err := Parse(tc.in)
+			perr, ok := err.(*ParseError)
+			if !ok {
+				t.Fatalf("wanted *ParseError, got %v", err)
+			}
+			if perr.Offset != tc.offset {
+				t.Errorf("wanted offset %d, got %d (%v)", tc.offset, perr.Offset, err)
+			}
+		})
+	}
+}
+
+func TestMustParsePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic")
+		}
+	}()
+	MustParse("x")
+}
+
+func bigDecimal(mant string, scale int) Decimal {
+	i, _ := new(big.Int).SetString(mant, 10)
+	return wrap(zero().SetBigMantScale(i, scale))
+}
@@ -0,0 +1,73 @@
+package money
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Scan implements the sql.Scanner interface.
+//
+// Sources may be []byte, string, int64 or float64. Text sources preserve their scale,
+// e.g. a NUMERIC(10,2) value of 1.00 scans to the equivalent of NewCents(100).
+// Use NullDecimal for nullable columns.
+func (d *Decimal) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		return d.UnmarshalText(v)
+	case string:
+		return d.UnmarshalText([]byte(v))
+	case int64:
+		*d = wrap(zero().SetMantScale(v, 0))
+		return nil
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return ErrNotFinite
+		}
+		return d.UnmarshalText([]byte(strconv.FormatFloat(v, 'g', -1, 64)))
+	case nil:
+		return fmt.Errorf("money: cannot scan NULL into Decimal, use NullDecimal")
+	default:
+		return fmt.Errorf("money: cannot scan %T into Decimal", src)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+//
+// Values are stored as strings with their scale preserved.
+func (d Decimal) Value() (driver.Value, error) {
+	text, err := d.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
+// NullDecimal represents a decimal that may be null.
+type NullDecimal struct {
+	Decimal Decimal
+	Valid   bool // Valid is true if Decimal is not NULL
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *NullDecimal) Scan(src interface{}) error {
+	if src == nil {
+		n.Decimal, n.Valid = Decimal{}, false
+		return nil
+	}
+	if err := n.Decimal.Scan(src); err != nil {
+		n.Valid = false
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (n NullDecimal) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Decimal.Value()
+}
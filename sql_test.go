@@ -0,0 +1,169 @@
+package money
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"math"
+	"testing"
+)
+
+// fakeDriver stores values passed to Exec and returns them from Query,
+// one row per value, in a single column.
+type fakeDriver struct{ conn *fakeConn }
+
+type fakeConn struct{ values []driver.Value }
+
+type fakeStmt struct {
+	conn  *fakeConn
+	query string
+}
+
+type fakeRows struct {
+	values []driver.Value
+	next   int
+}
+
+func (d fakeDriver) Open(string) (driver.Conn, error) { return d.conn, nil }
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) { return &fakeStmt{c, query}, nil }
+func (c *fakeConn) Close() error                              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)                 { return nil, fmt.Errorf("not supported") }
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.conn.values = append(s.conn.values, args...)
+	return driver.RowsAffected(len(args)), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &fakeRows{values: s.conn.values}, nil
+}
+
+func (r *fakeRows) Columns() []string { return []string{"amount"} }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.values) {
+		return io.EOF
+	}
+	dest[0] = r.values[r.next]
+	r.next++
+	return nil
+}
+
+var fakeDriverCount int
+
+func openFakeDB(t *testing.T, values ...driver.Value) (*sql.DB, *fakeConn) {
+	t.Helper()
+	conn := &fakeConn{values: values}
+	fakeDriverCount++
+	name := fmt.Sprintf("money-fake-%d", fakeDriverCount)
+	sql.Register(name, fakeDriver{conn})
+	db, err := sql.Open(name, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db, conn
+}
+
+func TestDecimal_Scan(t *testing.T) {
+	for _, tc := range []struct {
+		src  driver.Value
+		want Decimal
+	}{
+		{[]byte("1.00"), NewCents(100)},
+		{"12.345", NewScalar(12345, 3)},
+		{"-0.50", NewCents(-50)},
+		{int64(100), wrap(zero().SetMantScale(100, 0))},
+		{float64(1.25), NewScalar(125, 2)},
+		{float64(0.1), NewScalar(1, 1)},
+	} {
+		t.Run(fmt.Sprintf("%T(%v)", tc.src, tc.src), func(t *testing.T) {
+			db, _ := openFakeDB(t, tc.src)
+			defer db.Close()
+
+			var got Decimal
+			if err := db.QueryRow("SELECT amount").Scan(&got); err != nil {
+				t.Fatal(err)
+			}
+			assertIdentical(t, tc.want, got)
+		})
+	}
+}
+
+func TestDecimal_ScanErrors(t *testing.T) {
+	for _, src := range []interface{}{nil, true, "abc", []byte("NaN"), math.Inf(1), math.NaN()} {
+		var d Decimal
+		if err := d.Scan(src); err == nil {
+			t.Errorf("%T(%v): expected error", src, src)
+		}
+	}
+}
+
+func TestDecimal_Value(t *testing.T) {
+	db, conn := openFakeDB(t)
+	defer db.Close()
+
+	in := []Decimal{NewCents(100), Pc(2), New(-5), Decimal{}}
+	for _, d := range in {
+		if _, err := db.Exec("INSERT", d); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := []driver.Value{"1.00", "0.02", "-5.00", "0"}
+	if fmt.Sprint(conn.values) != fmt.Sprint(want) {
+		t.Errorf("wanted %v, got %v", want, conn.values)
+	}
+
+	rows, err := db.Query("SELECT amount")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	for i := 0; rows.Next(); i++ {
+		var got Decimal
+		if err := rows.Scan(&got); err != nil {
+			t.Fatal(err)
+		}
+		if in[i].value != nil && !got.Equals(in[i]) {
+			t.Errorf("#%d wanted %v, got %v", i, in[i], got)
+		}
+	}
+}
+
+func TestNullDecimal(t *testing.T) {
+	db, conn := openFakeDB(t)
+	defer db.Close()
+
+	for _, n := range []NullDecimal{{NewCents(250), true}, {}} {
+		if _, err := db.Exec("INSERT", n); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if conn.values[0] != "2.50" || conn.values[1] != nil {
+		t.Fatalf("unexpected values %v", conn.values)
+	}
+
+	rows, err := db.Query("SELECT amount")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	var got []NullDecimal
+	for rows.Next() {
+		var n NullDecimal
+		if err := rows.Scan(&n); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, n)
+	}
+	if len(got) != 2 || !got[0].Valid || !got[0].Decimal.Equals(NewCents(250)) || got[1].Valid {
+		t.Errorf("unexpected results %+v", got)
+	}
+}
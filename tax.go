@@ -0,0 +1,160 @@
+package money
+
+// Tax is a named tax rate, e.g. Tax{Name: "VAT", Rate: Pc(20)}.
+type Tax struct {
+	Name string
+	Rate Decimal
+
+	// Compound taxes are levied on the net amount plus all preceding taxes,
+	// otherwise only on the net amount.
+	Compound bool
+}
+
+// TaxResult is the breakdown of a taxed amount, where Gross = Net + the sum of Taxes.
+type TaxResult struct {
+	Net   Decimal
+	Taxes []Decimal // amount of each tax, in the order given
+	Gross Decimal
+}
+
+// TotalTax returns the sum of all taxes.
+func (r TaxResult) TotalTax() Decimal {
+	return sum(r.Taxes)
+}
+
+// TaxRounding determines at what level tax amounts are rounded.
+type TaxRounding int
+
+// Tax rounding levels.
+const (
+	// PerLine rounds the tax on each line, and the total tax is the sum of the rounded amounts.
+	PerLine TaxRounding = iota
+	// PerInvoice calculates the tax on the sum of all lines and rounds once.
+	PerInvoice
+)
+
+// TaxPolicy determines how tax amounts are rounded.
+type TaxPolicy struct {
+	DP       int          // decimal places each tax amount is rounded to, e.g. 2 for cents
+	Rounding RoundingMode // rounding mode used for each tax amount
+	Level    TaxRounding  // whether tax is rounded per line or per invoice
+}
+
+// EffectiveRate returns the combined rate of the taxes on a net amount,
+// e.g. 5% and a 9.5% compound tax have an effective rate of 14.975%.
+func EffectiveRate(taxes ...Tax) Decimal {
+	rates := taxAmounts(NewInt(1), taxes, nil)
+	return sum(rates)
+}
+
+// AddTax calculates the taxes on a net amount, without rounding.
+func AddTax(net Decimal, taxes ...Tax) TaxResult {
+	return TaxPolicy{}.add([]Decimal{net}, taxes, nil)
+}
+
+// ExtractTax calculates the taxes included in a gross amount, without rounding.
+func ExtractTax(gross Decimal, taxes ...Tax) TaxResult {
+	return TaxPolicy{}.extract([]Decimal{gross}, taxes, nil)
+}
+
+// AddTax calculates the taxes on the sum of the net line amounts.
+//
+// Compound taxes are levied on the rounded amounts of preceding taxes.
+func (p TaxPolicy) AddTax(lines []Decimal, taxes ...Tax) TaxResult {
+	return p.add(lines, taxes, p.round)
+}
+
+// ExtractTax calculates the taxes included in the sum of the gross line amounts.
+//
+// The gross amount is preserved exactly, so the net amount is the gross less the rounded taxes.
+func (p TaxPolicy) ExtractTax(lines []Decimal, taxes ...Tax) TaxResult {
+	return p.extract(lines, taxes, p.round)
+}
+
+func (p TaxPolicy) add(lines []Decimal, taxes []Tax, round func(Decimal) Decimal) TaxResult {
+	if p.Level == PerInvoice {
+		lines = []Decimal{sum(lines)}
+	}
+	r := TaxResult{Net: NewInt(0), Taxes: zeros(len(taxes))}
+	for _, line := range lines {
+		r.Net = r.Net.Add(line)
+		r.addTaxes(taxAmounts(line, taxes, round))
+	}
+	r.Gross = r.Net.Add(r.TotalTax())
+	return r
+}
+
+func (p TaxPolicy) extract(lines []Decimal, taxes []Tax, round func(Decimal) Decimal) TaxResult {
+	if p.Level == PerInvoice {
+		lines = []Decimal{sum(lines)}
+	}
+	factor := EffectiveRate(taxes...).AddInt(1)
+	r := TaxResult{Gross: NewInt(0), Taxes: zeros(len(taxes))}
+	for _, line := range lines {
+		r.Gross = r.Gross.Add(line)
+		r.addTaxes(taxAmounts(line.Div(factor), taxes, round))
+	}
+	r.Net = r.Gross.Sub(r.TotalTax())
+	return r
+}
+
+func (r TaxResult) addTaxes(amounts []Decimal) {
+	for i, a := range amounts {
+		r.Taxes[i] = r.Taxes[i].Add(a)
+	}
+}
+
+func (p TaxPolicy) round(d Decimal) Decimal {
+	return quantize(d, p.DP, p.Rounding)
+}
+
+// quantize rounds the decimal to exactly dp decimal places. Unlike RoundDP, values too small
+// to have a significant figure at dp are rounded too, and whole numbers are padded with zeros.
+// NaN and infinite values are returned unchanged.
+func quantize(d Decimal, dp int, mode RoundingMode) Decimal {
+	v := d.val()
+	if !v.IsFinite() {
+		return d
+	}
+	units := mantissa(v)
+	if v.Scale() <= dp {
+		units.Mul(units, pow10(dp-v.Scale()))
+	} else {
+		units = roundQuo(units, pow10(v.Scale()-dp), mode)
+	}
+	return wrap(zero().SetBigMantScale(units, dp))
+}
+
+// taxAmounts calculates each tax on a net amount, rounding each if round is not nil.
+func taxAmounts(net Decimal, taxes []Tax, round func(Decimal) Decimal) []Decimal {
+	amounts := make([]Decimal, len(taxes))
+	compoundBase := net
+	for i, t := range taxes {
+		base := net
+		if t.Compound {
+			base = compoundBase
+		}
+		amounts[i] = base.Mul(t.Rate)
+		if round != nil {
+			amounts[i] = round(amounts[i])
+		}
+		compoundBase = compoundBase.Add(amounts[i])
+	}
+	return amounts
+}
+
+func sum(ds []Decimal) Decimal {
+	total := NewInt(0)
+	for _, d := range ds {
+		total = total.Add(d)
+	}
+	return total
+}
+
+func zeros(n int) []Decimal {
+	ds := make([]Decimal, n)
+	for i := range ds {
+		ds[i] = NewInt(0)
+	}
+	return ds
+}
@@ -0,0 +1,156 @@
+package money
+
+import (
+	"fmt"
+	"testing"
+)
+
+var (
+	vat = Tax{Name: "VAT", Rate: Pc(20)}
+	gst = Tax{Name: "GST", Rate: Pc(5)}
+	qst = Tax{Name: "QST", Rate: NewScalar(9975, 5)}
+)
+
+func ExampleExtractTax() {
+	r := ExtractTax(New(120), vat)
+	fmt.Printf("%.2f + %.2f = %.2f", r.Net, r.TotalTax(), r.Gross)
+	// Output: 100.00 + 20.00 = 120.00
+}
+
+func ExampleTaxPolicy_AddTax() {
+	lines := []Decimal{NewCents(99), NewCents(99), NewCents(99)}
+	perLine := TaxPolicy{DP: 2, Level: PerLine}.AddTax(lines, vat)
+	perInvoice := TaxPolicy{DP: 2, Level: PerInvoice}.AddTax(lines, vat)
+	fmt.Println(perLine.Gross, perInvoice.Gross)
+	// Output: 3.57 3.56
+}
+
+func ExampleTaxPolicy_AddTax_compound() {
+	r := TaxPolicy{DP: 2}.AddTax([]Decimal{New(100)}, gst, Tax{Name: "QST", Rate: NewScalar(85, 3), Compound: true})
+	fmt.Println(r.Taxes, r.Gross)
+	// Output: [5.00 8.92] 113.92
+}
+
+func TestEffectiveRate(t *testing.T) {
+	for _, tc := range []struct {
+		taxes []Tax
+		want  Decimal
+	}{
+		{nil, NewInt(0)},
+		{[]Tax{vat}, Pc(20)},
+		{[]Tax{gst, qst}, NewScalar(14975, 5)},
+		{[]Tax{gst, {Rate: Pc(10), Compound: true}}, NewScalar(155, 3)},
+		{[]Tax{gst, {Rate: Pc(10), Compound: true}, {Rate: Pc(10), Compound: true}}, NewScalar(2705, 4)},
+	} {
+		if got := EffectiveRate(tc.taxes...); !got.Equals(tc.want) {
+			t.Errorf("%v: wanted %v, got %v", tc.taxes, tc.want, got)
+		}
+	}
+}
+
+func TestAddTax(t *testing.T) {
+	r := AddTax(NewCents(1999), gst, qst)
+	if want := "[0.9995 1.9940025]"; fmt.Sprint(r.Taxes) != want {
+		t.Errorf("wanted taxes %s, got %v", want, r.Taxes)
+	}
+	if want := NewScalar(229835025, 7); !r.Gross.Equals(want) {
+		t.Errorf("wanted gross %v, got %v", want, r.Gross)
+	}
+}
+
+func TestExtractTax_RoundTrip(t *testing.T) {
+	for _, taxes := range [][]Tax{{vat}, {gst, qst}, {gst, {Rate: Pc(10), Compound: true}}} {
+		net := NewCents(123456)
+		gross := AddTax(net, taxes...).Gross
+		r := ExtractTax(gross, taxes...)
+		if !r.Net.EqualTo(net, 30) {
+			t.Errorf("%v: wanted net %v, got %v", taxes, net, r.Net)
+		}
+		if !r.Gross.Equals(gross) {
+			t.Errorf("%v: wanted gross %v, got %v", taxes, gross, r.Gross)
+		}
+	}
+}
+
+func TestTaxPolicy(t *testing.T) {
+	lines := []Decimal{NewCents(999), NewCents(999), NewCents(999)}
+	for _, tc := range []struct {
+		name   string
+		policy TaxPolicy
+		fn     func(TaxPolicy, []Decimal, ...Tax) TaxResult
+		taxes  []Tax
+		want   string
+	}{
+		{"add per line", TaxPolicy{DP: 2}, TaxPolicy.AddTax, []Tax{vat}, "29.97 [6.00] 35.97"},
+		{"add per invoice", TaxPolicy{DP: 2, Level: PerInvoice}, TaxPolicy.AddTax, []Tax{vat}, "29.97 [5.99] 35.96"},
+		{"add quebec", TaxPolicy{DP: 2}, TaxPolicy.AddTax, []Tax{gst, qst}, "29.97 [1.50 3.00] 34.47"},
+		{"add quebec per invoice", TaxPolicy{DP: 2, Level: PerInvoice}, TaxPolicy.AddTax, []Tax{gst, qst}, "29.97 [1.50 2.99] 34.46"},
+		{"extract per line even", TaxPolicy{DP: 2}, TaxPolicy.ExtractTax, []Tax{vat}, "24.99 [4.98] 29.97"},
+		{"extract per line away", TaxPolicy{DP: 2, Rounding: ToNearestAway}, TaxPolicy.ExtractTax, []Tax{vat}, "24.96 [5.01] 29.97"},
+		{"extract per invoice", TaxPolicy{DP: 2, Level: PerInvoice}, TaxPolicy.ExtractTax, []Tax{vat}, "24.97 [5.00] 29.97"},
+		{"extract quebec", TaxPolicy{DP: 2}, TaxPolicy.ExtractTax, []Tax{gst, qst}, "26.07 [1.29 2.61] 29.97"},
+		{"extract quebec per invoice", TaxPolicy{DP: 2, Level: PerInvoice}, TaxPolicy.ExtractTax, []Tax{gst, qst}, "26.07 [1.30 2.60] 29.97"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.fn(tc.policy, lines, tc.taxes...)
+			if got := fmt.Sprintf("%.2f %.2f %.2f", r.Net, r.Taxes, r.Gross); got != tc.want {
+				t.Errorf("wanted %s, got %s", tc.want, got)
+			}
+			if !r.Net.Add(r.TotalTax()).Equals(r.Gross) {
+				t.Errorf("%v + %v != %v", r.Net, r.Taxes, r.Gross)
+			}
+		})
+	}
+}
+
+func TestTaxPolicy_SubCent(t *testing.T) {
+	lines := []Decimal{NewCents(3), NewCents(3), NewCents(3)}
+	for _, tc := range []struct {
+		name   string
+		policy TaxPolicy
+		lines  []Decimal
+		want   Decimal
+	}{
+		{"round down", TaxPolicy{DP: 2}, []Decimal{NewCents(1)}, NewInt(0)},
+		{"round up", TaxPolicy{DP: 2, Rounding: ToPositiveInf}, []Decimal{NewCents(1)}, NewCents(1)},
+		{"per line", TaxPolicy{DP: 2}, lines, NewCents(3)},
+		{"per invoice", TaxPolicy{DP: 2, Level: PerInvoice}, lines, NewCents(2)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.policy.AddTax(tc.lines, vat).TotalTax(); !got.Equals(tc.want) {
+				t.Errorf("wanted %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestQuantize(t *testing.T) {
+	for _, tc := range []struct {
+		d    Decimal
+		dp   int
+		mode RoundingMode
+		want string
+	}{
+		{NewScalar(1, 5), 2, ToNearestEven, "0"},
+		{NewScalar(6, 1), 0, ToNearestEven, "1"},
+		{NewScalar(4, 3), 2, ToPositiveInf, "0.01"},
+		{NewScalar(-4, 3), 2, ToNegativeInf, "-0.01"},
+		{NewScalar(-4, 3), 2, ToPositiveInf, "0"},
+		{NewScalar(5, 3), 2, ToNearestEven, "0"},
+		{NewScalar(15, 3), 2, ToNearestEven, "0.02"},
+		{NewScalar(-5, 3), 2, ToNearestAway, "-0.01"},
+		{NewScalar(-6, 3), 2, ToZero, "0"},
+		{NewScalar(1, 3), 2, AwayFromZero, "0.01"},
+		{NewScalar(-995, 3), 2, ToNearestEven, "-1.00"},
+		{NewInt(4), 2, ToNearestEven, "4.00"},
+		{NewScalar(9996, 3), 2, ToNearestEven, "10.00"},
+		{Decimal{}, 2, ToNearestEven, "0"},
+		{MustParse("10000000000000000000000000000000000000000.5"), 0, ToNearestEven, "10000000000000000000000000000000000000000"},
+		{MustParse("1234567890123456789012345678901234567890.125"), 2, ToNearestEven, "1234567890123456789012345678901234567890.12"},
+		{wrap(zero().SetNaN(false)), 2, ToNearestEven, "NaN"},
+	} {
+		if got := fmt.Sprint(quantize(tc.d, tc.dp, tc.mode)); got != tc.want {
+			t.Errorf("%v to %d dp: wanted %s, got %s", tc.d, tc.dp, tc.want, got)
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package money
+
+import (
+	"math/big"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Speller writes monetary amounts in words, e.g. for cheques and invoices.
+type Speller struct {
+	Language Language // English if nil
+
+	// MinorDigits writes the minor units as digits, e.g. "and 56 pence", and always includes them.
+	MinorDigits bool
+
+	// Capitalize writes the first letter in upper case.
+	Capitalize bool
+
+	// Rounding is used for amounts with more decimal places than the currency's minor units.
+	Rounding RoundingMode
+}
+
+// Language spells out numbers and currency units.
+//
+// English, French, German and Spanish are provided. Other languages can be added by implementing
+// this interface.
+type Language interface {
+	// Cardinal spells out a non-negative integer, e.g. 21 is "twenty-one".
+	Cardinal(n *big.Int) string
+
+	// Count spells out a non-negative quantity of a unit, e.g. "twenty-one pounds",
+	// with any agreement between the number and unit the language requires.
+	Count(n *big.Int, u Unit) string
+
+	// UnitName returns the form of the unit name used with a quantity, e.g. "pence" for 56.
+	UnitName(n *big.Int, u Unit) string
+
+	// Units returns the names of a currency's major and minor units.
+	Units(c Currency) (major, minor Unit)
+
+	// Join combines the major and minor parts of an amount, e.g. "x and y".
+	Join(major, minor string) string
+
+	// Negative writes a negative amount, e.g. "minus x".
+	Negative(s string) string
+}
+
+// Unit is the name of a unit of currency.
+type Unit struct {
+	Singular string
+	Plural   string
+	Feminine bool // grammatical gender, for languages in which numbers agree with the unit
+}
+
+// Languages provided by the package.
+var (
+	English Language = english{}
+	French  Language = french{}
+	German  Language = german{}
+	Spanish Language = spanish{}
+)
+
+// Spell writes a monetary amount in words, e.g. 1234.56 GBP in English is
+// "one thousand two hundred thirty-four pounds and fifty-six pence".
+//
+// The amount is rounded to the currency's minor units. A zero minor part is omitted,
+// as is a zero major part with a non-zero minor part, unless MinorDigits is set.
+// NaN and infinite amounts are spelled as zero.
+func (s Speller) Spell(m Money) string {
+	lang := s.Language
+	if lang == nil {
+		lang = English
+	}
+
+	amount := orZero(m.amount)
+	if !amount.value.IsFinite() {
+		amount = NewInt(0)
+	}
+	minorUnits := m.currency.MinorUnits
+	units := new(big.Int).Abs(mantissa(quantize(amount, minorUnits, s.Rounding).value))
+	major, minor := new(big.Int).QuoRem(units, pow10(minorUnits), new(big.Int))
+
+	majorUnit, minorUnit := lang.Units(m.currency)
+	var words string
+	switch {
+	case minorUnits == 0 || (minor.Sign() == 0 && !s.MinorDigits):
+		words = lang.Count(major, majorUnit)
+	case s.MinorDigits:
+		digits := minor.String()
+		digits = strings.Repeat("0", minorUnits-len(digits)) + digits
+		words = lang.Join(lang.Count(major, majorUnit), digits+" "+lang.UnitName(minor, minorUnit))
+	case major.Sign() == 0:
+		words = lang.Count(minor, minorUnit)
+	default:
+		words = lang.Join(lang.Count(major, majorUnit), lang.Count(minor, minorUnit))
+	}
+
+	if units.Sign() != 0 && amount.value.Signbit() {
+		words = lang.Negative(words)
+	}
+	if s.Capitalize {
+		r, size := utf8.DecodeRuneInString(words)
+		words = string(unicode.ToUpper(r)) + words[size:]
+	}
+	return words
+}
+
+// splitGroups splits a non-negative integer into count groups of digits in the given base,
+// least significant first, where the last group holds all of the remaining high digits.
+func splitGroups(n *big.Int, base int64, count int) ([]int, *big.Int) {
+	groups := make([]int, count-1)
+	high, rem, b := new(big.Int).Set(n), new(big.Int), big.NewInt(base)
+	for i := range groups {
+		high.QuoRem(high, b, rem)
+		groups[i] = int(rem.Int64())
+	}
+	return groups, high
+}
+
+// isOne returns true if n is 1.
+func isOne(n *big.Int) bool {
+	return n.IsInt64() && n.Int64() == 1
+}
+
+// localUnits finds a currency's units in a language's table, using the currency code
+// for any other major unit.
+func localUnits(table map[string][2]Unit, c Currency, minor Unit) (Unit, Unit) {
+	if u, ok := table[c.Code]; ok {
+		return u[0], u[1]
+	}
+	return Unit{Singular: c.Code, Plural: c.Code}, minor
+}
+
+type english struct{}
+
+var (
+	englishOnes = []string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+		"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen",
+	}
+	englishTens   = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+	englishScales = []string{
+		"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion",
+		"sextillion", "septillion", "octillion", "nonillion", "decillion",
+	}
+	englishUnits = map[string][2]Unit{
+		"AUD": {{Singular: "dollar", Plural: "dollars"}, {Singular: "cent", Plural: "cents"}},
+		"CAD": {{Singular: "dollar", Plural: "dollars"}, {Singular: "cent", Plural: "cents"}},
+		"CHF": {{Singular: "franc", Plural: "francs"}, {Singular: "centime", Plural: "centimes"}},
+		"EUR": {{Singular: "euro", Plural: "euros"}, {Singular: "cent", Plural: "cents"}},
+		"GBP": {{Singular: "pound", Plural: "pounds"}, {Singular: "penny", Plural: "pence"}},
+		"INR": {{Singular: "rupee", Plural: "rupees"}, {Singular: "paisa", Plural: "paise"}},
+		"JPY": {{Singular: "yen", Plural: "yen"}, {}},
+		"KWD": {{Singular: "dinar", Plural: "dinars"}, {Singular: "fils", Plural: "fils"}},
+		"MXN": {{Singular: "peso", Plural: "pesos"}, {Singular: "centavo", Plural: "centavos"}},
+		"NZD": {{Singular: "dollar", Plural: "dollars"}, {Singular: "cent", Plural: "cents"}},
+		"USD": {{Singular: "dollar", Plural: "dollars"}, {Singular: "cent", Plural: "cents"}},
+	}
+)
+
+func (english) Cardinal(n *big.Int) string {
+	if n.Sign() == 0 {
+		return "zero"
+	}
+	groups, high := splitGroups(n, 1000, len(englishScales))
+	var words []string
+	if high.Sign() > 0 {
+		words = append(words, english{}.Cardinal(high), englishScales[len(englishScales)-1])
+	}
+	for i := len(groups) - 1; i >= 0; i-- {
+		if groups[i] == 0 {
+			continue
+		}
+		words = append(words, englishHundreds(groups[i]))
+		if i > 0 {
+			words = append(words, englishScales[i])
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// englishHundreds spells out 1 to 999.
+func englishHundreds(n int) string {
+	var words []string
+	if n >= 100 {
+		words = append(words, englishOnes[n/100], "hundred")
+		n %= 100
+	}
+	switch {
+	case n == 0:
+	case n < 20:
+		words = append(words, englishOnes[n])
+	case n%10 == 0:
+		words = append(words, englishTens[n/10])
+	default:
+		words = append(words, englishTens[n/10]+"-"+englishOnes[n%10])
+	}
+	return strings.Join(words, " ")
+}
+
+func (e english) Count(n *big.Int, u Unit) string {
+	return e.Cardinal(n) + " " + e.UnitName(n, u)
+}
+
+func (english) UnitName(n *big.Int, u Unit) string {
+	if isOne(n) {
+		return u.Singular
+	}
+	return u.Plural
+}
+
+func (english) Units(c Currency) (Unit, Unit) {
+	return localUnits(englishUnits, c, Unit{Singular: "cent", Plural: "cents"})
+}
+
+func (english) Join(major, minor string) string { return major + " and " + minor }
+
+func (english) Negative(s string) string { return "minus " + s }
@@ -0,0 +1,153 @@
+package money
+
+import (
+	"math/big"
+	"strings"
+)
+
+type french struct{}
+
+var (
+	frenchOnes = []string{
+		"zéro", "un", "deux", "trois", "quatre", "cinq", "six", "sept", "huit", "neuf",
+		"dix", "onze", "douze", "treize", "quatorze", "quinze", "seize", "dix-sept", "dix-huit", "dix-neuf",
+	}
+	frenchTens   = []string{"", "", "vingt", "trente", "quarante", "cinquante", "soixante"}
+	frenchScales = []string{
+		"", "mille", "million", "milliard", "billion", "billiard", "trillion",
+		"trilliard", "quadrillion", "quadrilliard", "quintillion", "quintilliard",
+	}
+	frenchUnits = map[string][2]Unit{
+		"AUD": {{Singular: "dollar", Plural: "dollars"}, {Singular: "cent", Plural: "cents"}},
+		"CAD": {{Singular: "dollar", Plural: "dollars"}, {Singular: "cent", Plural: "cents"}},
+		"CHF": {{Singular: "franc", Plural: "francs"}, {Singular: "centime", Plural: "centimes"}},
+		"EUR": {{Singular: "euro", Plural: "euros"}, {Singular: "centime", Plural: "centimes"}},
+		"GBP": {{Singular: "livre", Plural: "livres", Feminine: true}, {Singular: "penny", Plural: "pence"}},
+		"JPY": {{Singular: "yen", Plural: "yens"}, {}},
+		"MXN": {{Singular: "peso", Plural: "pesos"}, {Singular: "centavo", Plural: "centavos"}},
+		"USD": {{Singular: "dollar", Plural: "dollars"}, {Singular: "cent", Plural: "cents"}},
+		"XOF": {{Singular: "franc CFA", Plural: "francs CFA"}, {}},
+	}
+)
+
+func (f french) Cardinal(n *big.Int) string {
+	return f.cardinal(n, false)
+}
+
+// cardinal spells out a non-negative integer, using "une" for a final "un" if feminine.
+func (f french) cardinal(n *big.Int, feminine bool) string {
+	if n.Sign() == 0 {
+		return frenchOnes[0]
+	}
+	groups, high := splitGroups(n, 1000, len(frenchScales))
+	var words []string
+	top := frenchScales[len(frenchScales)-1]
+	switch {
+	case high.Sign() == 0:
+	case isOne(high):
+		words = append(words, "un", top)
+	default:
+		words = append(words, f.cardinal(high, false), top+"s")
+	}
+	for i := len(groups) - 1; i >= 0; i-- {
+		g := groups[i]
+		switch {
+		case g == 0:
+		case i == 0:
+			words = append(words, frenchHundreds(g, true, feminine))
+		case i == 1 && g == 1:
+			words = append(words, "mille")
+		case i == 1:
+			// mille is invariable and cent and vingt are not pluralised before it
+			words = append(words, frenchHundreds(g, false, false), "mille")
+		case g == 1:
+			words = append(words, "un", frenchScales[i])
+		default:
+			words = append(words, frenchHundreds(g, true, false), frenchScales[i]+"s")
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// frenchHundreds spells out 1 to 999. Final cent and vingt are pluralised if plural is set.
+func frenchHundreds(n int, plural, feminine bool) string {
+	var words []string
+	h, r := n/100, n%100
+	if h > 1 {
+		words = append(words, frenchOnes[h])
+	}
+	if h > 0 {
+		if h > 1 && r == 0 && plural {
+			words = append(words, "cents")
+		} else {
+			words = append(words, "cent")
+		}
+	}
+	if r > 0 {
+		words = append(words, frenchTensUnits(r, plural, feminine))
+	}
+	return strings.Join(words, " ")
+}
+
+// frenchTensUnits spells out 1 to 99.
+func frenchTensUnits(n int, plural, feminine bool) string {
+	t, u := n/10, n%10
+	var s string
+	switch {
+	case n < 20:
+		s = frenchOnes[n]
+	case t < 7 && u == 0:
+		s = frenchTens[t]
+	case t < 7 && u == 1:
+		s = frenchTens[t] + " et un"
+	case t < 7:
+		s = frenchTens[t] + "-" + frenchOnes[u]
+	case n == 71:
+		s = "soixante et onze"
+	case t == 7:
+		s = "soixante-" + frenchOnes[n-60]
+	case n == 80 && plural:
+		s = "quatre-vingts"
+	case n == 80:
+		s = "quatre-vingt"
+	default:
+		s = "quatre-vingt-" + frenchOnes[n-80]
+	}
+	if feminine && strings.HasSuffix(s, "un") {
+		s += "e"
+	}
+	return s
+}
+
+func (f french) Count(n *big.Int, u Unit) string {
+	name := f.UnitName(n, u)
+	if isWholeMillions(n) {
+		if strings.ContainsAny(name[:1], "aeiouhy") {
+			return f.cardinal(n, false) + " d'" + name
+		}
+		return f.cardinal(n, false) + " de " + name
+	}
+	return f.cardinal(n, u.Feminine) + " " + name
+}
+
+func (french) UnitName(n *big.Int, u Unit) string {
+	if n.Cmp(big.NewInt(2)) < 0 {
+		return u.Singular
+	}
+	return u.Plural
+}
+
+func (french) Units(c Currency) (Unit, Unit) {
+	return localUnits(frenchUnits, c, Unit{Singular: "centime", Plural: "centimes"})
+}
+
+func (french) Join(major, minor string) string { return major + " et " + minor }
+
+func (french) Negative(s string) string { return "moins " + s }
+
+// isWholeMillions returns true if n is a non-zero multiple of a million, which in some languages
+// are nouns taking a preposition before the unit e.g. "un million d'euros".
+func isWholeMillions(n *big.Int) bool {
+	million := big.NewInt(1000000)
+	return n.Cmp(million) >= 0 && new(big.Int).Rem(n, million).Sign() == 0
+}
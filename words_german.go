@@ -0,0 +1,121 @@
+package money
+
+import (
+	"math/big"
+	"strings"
+)
+
+type german struct{}
+
+var (
+	germanOnes = []string{
+		"null", "eins", "zwei", "drei", "vier", "fünf", "sechs", "sieben", "acht", "neun",
+		"zehn", "elf", "zwölf", "dreizehn", "vierzehn", "fünfzehn", "sechzehn", "siebzehn", "achtzehn", "neunzehn",
+	}
+	germanTens   = []string{"", "", "zwanzig", "dreißig", "vierzig", "fünfzig", "sechzig", "siebzig", "achtzig", "neunzig"}
+	germanScales = [][2]string{
+		{}, {}, {"Million", "Millionen"}, {"Milliarde", "Milliarden"}, {"Billion", "Billionen"}, {"Billiarde", "Billiarden"},
+		{"Trillion", "Trillionen"}, {"Trilliarde", "Trilliarden"}, {"Quadrillion", "Quadrillionen"},
+		{"Quadrilliarde", "Quadrilliarden"}, {"Quintillion", "Quintillionen"}, {"Quintilliarde", "Quintilliarden"},
+	}
+	germanUnits = map[string][2]Unit{
+		"CHF": {{Singular: "Franken", Plural: "Franken"}, {Singular: "Rappen", Plural: "Rappen"}},
+		"DKK": {{Singular: "Krone", Plural: "Kronen", Feminine: true}, {Singular: "Øre", Plural: "Øre"}},
+		"EUR": {{Singular: "Euro", Plural: "Euro"}, {Singular: "Cent", Plural: "Cent"}},
+		"GBP": {{Singular: "Pfund", Plural: "Pfund"}, {Singular: "Penny", Plural: "Pence"}},
+		"JPY": {{Singular: "Yen", Plural: "Yen"}, {}},
+		"SEK": {{Singular: "Krone", Plural: "Kronen", Feminine: true}, {Singular: "Öre", Plural: "Öre"}},
+		"USD": {{Singular: "Dollar", Plural: "Dollar"}, {Singular: "Cent", Plural: "Cent"}},
+	}
+)
+
+// Cardinal writes numbers below a million as a single word, e.g. "eintausendzweihundertvierunddreißig".
+func (g german) Cardinal(n *big.Int) string {
+	if n.Sign() == 0 {
+		return germanOnes[0]
+	}
+	groups, high := splitGroups(n, 1000, len(germanScales))
+	var words []string
+	top := germanScales[len(germanScales)-1]
+	switch {
+	case high.Sign() == 0:
+	case isOne(high):
+		words = append(words, "eine", top[0])
+	default:
+		words = append(words, germanAttributive(g.Cardinal(high)), top[1])
+	}
+	for i := len(groups) - 1; i >= 2; i-- {
+		switch {
+		case groups[i] == 0:
+		case groups[i] == 1:
+			words = append(words, "eine", germanScales[i][0])
+		default:
+			words = append(words, germanAttributive(germanHundreds(groups[i])), germanScales[i][1])
+		}
+	}
+
+	var low string
+	switch {
+	case groups[1] == 0:
+	case groups[1] == 1:
+		low = "eintausend"
+	default:
+		low = germanAttributive(germanHundreds(groups[1])) + "tausend"
+	}
+	if groups[0] > 0 {
+		low += germanHundreds(groups[0])
+	}
+	if low != "" {
+		words = append(words, low)
+	}
+	return strings.Join(words, " ")
+}
+
+// germanHundreds spells out 1 to 999.
+func germanHundreds(n int) string {
+	var s string
+	h, r := n/100, n%100
+	if h > 0 {
+		s = germanAttributive(germanOnes[h]) + "hundert"
+	}
+	switch {
+	case r == 0:
+	case r < 20:
+		s += germanOnes[r]
+	case r%10 == 0:
+		s += germanTens[r/10]
+	default:
+		s += germanAttributive(germanOnes[r%10]) + "und" + germanTens[r/10]
+	}
+	return s
+}
+
+// germanAttributive uses "ein" rather than "eins" for a number followed by a noun or another number.
+func germanAttributive(s string) string {
+	if strings.HasSuffix(s, "eins") {
+		return strings.TrimSuffix(s, "s")
+	}
+	return s
+}
+
+func (g german) Count(n *big.Int, u Unit) string {
+	if isOne(n) && u.Feminine {
+		return "eine " + u.Singular
+	}
+	return germanAttributive(g.Cardinal(n)) + " " + g.UnitName(n, u)
+}
+
+func (german) UnitName(n *big.Int, u Unit) string {
+	if isOne(n) {
+		return u.Singular
+	}
+	return u.Plural
+}
+
+func (german) Units(c Currency) (Unit, Unit) {
+	return localUnits(germanUnits, c, Unit{Singular: "Cent", Plural: "Cent"})
+}
+
+func (german) Join(major, minor string) string { return major + " und " + minor }
+
+func (german) Negative(s string) string { return "minus " + s }
@@ -0,0 +1,159 @@
+package money
+
+import (
+	"math/big"
+	"strings"
+)
+
+type spanish struct{}
+
+// spanishForm is the form of a final "uno", which agrees with the noun that follows.
+type spanishForm int
+
+const (
+	spanishUno spanishForm = iota // standalone, e.g. "veintiuno"
+	spanishUn                     // before a masculine noun, e.g. "veintiún"
+	spanishUna                    // before a feminine noun, e.g. "veintiuna"
+)
+
+var (
+	spanishOnes = []string{
+		"cero", "uno", "dos", "tres", "cuatro", "cinco", "seis", "siete", "ocho", "nueve",
+		"diez", "once", "doce", "trece", "catorce", "quince", "dieciséis", "diecisiete", "dieciocho", "diecinueve",
+		"veinte", "veintiuno", "veintidós", "veintitrés", "veinticuatro", "veinticinco", "veintiséis", "veintisiete", "veintiocho", "veintinueve",
+	}
+	spanishTens     = []string{"", "", "", "treinta", "cuarenta", "cincuenta", "sesenta", "setenta", "ochenta", "noventa"}
+	spanishHundreds = []string{
+		"", "ciento", "doscientos", "trescientos", "cuatrocientos", "quinientos", "seiscientos", "setecientos", "ochocientos", "novecientos",
+	}
+	spanishScales = [][2]string{
+		{}, {"millón", "millones"}, {"billón", "billones"}, {"trillón", "trillones"},
+		{"cuatrillón", "cuatrillones"}, {"quintillón", "quintillones"},
+	}
+	spanishUnits = map[string][2]Unit{
+		"ARS": {{Singular: "peso", Plural: "pesos"}, {Singular: "centavo", Plural: "centavos"}},
+		"CHF": {{Singular: "franco", Plural: "francos"}, {Singular: "céntimo", Plural: "céntimos"}},
+		"CLP": {{Singular: "peso", Plural: "pesos"}, {}},
+		"COP": {{Singular: "peso", Plural: "pesos"}, {Singular: "centavo", Plural: "centavos"}},
+		"EUR": {{Singular: "euro", Plural: "euros"}, {Singular: "céntimo", Plural: "céntimos"}},
+		"GBP": {{Singular: "libra", Plural: "libras", Feminine: true}, {Singular: "penique", Plural: "peniques"}},
+		"JPY": {{Singular: "yen", Plural: "yenes"}, {}},
+		"MXN": {{Singular: "peso", Plural: "pesos"}, {Singular: "centavo", Plural: "centavos"}},
+		"USD": {{Singular: "dólar", Plural: "dólares"}, {Singular: "centavo", Plural: "centavos"}},
+	}
+)
+
+// Cardinal uses the long scale, e.g. a thousand million is "mil millones" and 10^12 is "un billón".
+func (s spanish) Cardinal(n *big.Int) string {
+	return s.cardinal(n, spanishUno)
+}
+
+func (s spanish) cardinal(n *big.Int, form spanishForm) string {
+	if n.Sign() == 0 {
+		return spanishOnes[0]
+	}
+	groups, high := splitGroups(n, 1000000, len(spanishScales))
+	var words []string
+	top := spanishScales[len(spanishScales)-1]
+	switch {
+	case high.Sign() == 0:
+	case isOne(high):
+		words = append(words, "un", top[0])
+	default:
+		words = append(words, s.cardinal(high, spanishUn), top[1])
+	}
+	for i := len(groups) - 1; i >= 1; i-- {
+		switch {
+		case groups[i] == 0:
+		case groups[i] == 1:
+			words = append(words, "un", spanishScales[i][0])
+		default:
+			words = append(words, spanishBelowMillion(groups[i], spanishUn), spanishScales[i][1])
+		}
+	}
+	if groups[0] > 0 {
+		words = append(words, spanishBelowMillion(groups[0], form))
+	}
+	return strings.Join(words, " ")
+}
+
+// spanishBelowMillion spells out 1 to 999999.
+func spanishBelowMillion(n int, form spanishForm) string {
+	var words []string
+	t, r := n/1000, n%1000
+	switch {
+	case t == 1:
+		words = append(words, "mil")
+	case t > 1 && form == spanishUna:
+		words = append(words, spanishBelowThousand(t, spanishUna), "mil")
+	case t > 1:
+		words = append(words, spanishBelowThousand(t, spanishUn), "mil")
+	}
+	if r > 0 {
+		words = append(words, spanishBelowThousand(r, form))
+	}
+	return strings.Join(words, " ")
+}
+
+// spanishBelowThousand spells out 1 to 999.
+func spanishBelowThousand(n int, form spanishForm) string {
+	var words []string
+	h, r := n/100, n%100
+	switch {
+	case n == 100:
+		return "cien"
+	case h > 0 && form == spanishUna:
+		words = append(words, strings.Replace(spanishHundreds[h], "ientos", "ientas", 1))
+	case h > 0:
+		words = append(words, spanishHundreds[h])
+	}
+
+	var s string
+	switch {
+	case r == 0:
+	case r < 30:
+		s = spanishOnes[r]
+	case r%10 == 0:
+		s = spanishTens[r/10]
+	default:
+		s = spanishTens[r/10] + " y " + spanishOnes[r%10]
+	}
+	switch {
+	case form == spanishUn && s == "veintiuno":
+		s = "veintiún"
+	case form == spanishUn && strings.HasSuffix(s, "uno"):
+		s = strings.TrimSuffix(s, "o")
+	case form == spanishUna && strings.HasSuffix(s, "uno"):
+		s = strings.TrimSuffix(s, "o") + "a"
+	}
+	if s != "" {
+		words = append(words, s)
+	}
+	return strings.Join(words, " ")
+}
+
+func (s spanish) Count(n *big.Int, u Unit) string {
+	if isWholeMillions(n) {
+		return s.cardinal(n, spanishUn) + " de " + s.UnitName(n, u)
+	}
+	form := spanishUn
+	if u.Feminine {
+		form = spanishUna
+	}
+	return s.cardinal(n, form) + " " + s.UnitName(n, u)
+}
+
+func (spanish) UnitName(n *big.Int, u Unit) string {
+	if isOne(n) {
+		return u.Singular
+	}
+	return u.Plural
+}
+
+func (spanish) Units(c Currency) (Unit, Unit) {
+	return localUnits(spanishUnits, c, Unit{Singular: "céntimo", Plural: "céntimos"})
+}
+
+func (spanish) Join(major, minor string) string { return major + " con " + minor }
+
+func (spanish) Negative(s string) string { return "menos " + s }
@@ -0,0 +1,140 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+)
+
+func ExampleSpeller_Spell() {
+	gbp := NewMoney(NewCents(123456), MustCurrency("GBP"))
+	fmt.Println(Speller{}.Spell(gbp))
+	fmt.Println(Speller{MinorDigits: true, Capitalize: true}.Spell(gbp))
+	fmt.Println(Speller{Language: French}.Spell(gbp))
+	fmt.Println(Speller{Language: German}.Spell(gbp))
+	fmt.Println(Speller{Language: Spanish}.Spell(gbp))
+	// Output:
+	// one thousand two hundred thirty-four pounds and fifty-six pence
+	// One thousand two hundred thirty-four pounds and 56 pence
+	// mille deux cent trente-quatre livres et cinquante-six pence
+	// eintausendzweihundertvierunddreißig Pfund und sechsundfünfzig Pence
+	// mil doscientas treinta y cuatro libras con cincuenta y seis peniques
+}
+
+func TestLanguage_Cardinal(t *testing.T) {
+	for _, tc := range []struct {
+		n                                string
+		english, french, german, spanish string
+	}{
+		{"0", "zero", "zéro", "null", "cero"},
+		{"1", "one", "un", "eins", "uno"},
+		{"16", "sixteen", "seize", "sechzehn", "dieciséis"},
+		{"17", "seventeen", "dix-sept", "siebzehn", "diecisiete"},
+		{"21", "twenty-one", "vingt et un", "einundzwanzig", "veintiuno"},
+		{"22", "twenty-two", "vingt-deux", "zweiundzwanzig", "veintidós"},
+		{"70", "seventy", "soixante-dix", "siebzig", "setenta"},
+		{"71", "seventy-one", "soixante et onze", "einundsiebzig", "setenta y uno"},
+		{"80", "eighty", "quatre-vingts", "achtzig", "ochenta"},
+		{"81", "eighty-one", "quatre-vingt-un", "einundachtzig", "ochenta y uno"},
+		{"99", "ninety-nine", "quatre-vingt-dix-neuf", "neunundneunzig", "noventa y nueve"},
+		{"100", "one hundred", "cent", "einhundert", "cien"},
+		{"101", "one hundred one", "cent un", "einhunderteins", "ciento uno"},
+		{"200", "two hundred", "deux cents", "zweihundert", "doscientos"},
+		{"280", "two hundred eighty", "deux cent quatre-vingts", "zweihundertachtzig", "doscientos ochenta"},
+		{"1000", "one thousand", "mille", "eintausend", "mil"},
+		{"1001", "one thousand one", "mille un", "eintausendeins", "mil uno"},
+		{"2000", "two thousand", "deux mille", "zweitausend", "dos mil"},
+		{"21000", "twenty-one thousand", "vingt et un mille", "einundzwanzigtausend", "veintiún mil"},
+		{"80000", "eighty thousand", "quatre-vingt mille", "achtzigtausend", "ochenta mil"},
+		{"200000", "two hundred thousand", "deux cent mille", "zweihunderttausend", "doscientos mil"},
+		{"1000000", "one million", "un million", "eine Million", "un millón"},
+		{"2000000", "two million", "deux millions", "zwei Millionen", "dos millones"},
+		{"200000000", "two hundred million", "deux cents millions", "zweihundert Millionen", "doscientos millones"},
+		{"21000000", "twenty-one million", "vingt et un millions", "einundzwanzig Millionen", "veintiún millones"},
+		{"1000000000", "one billion", "un milliard", "eine Milliarde", "mil millones"},
+		{"1234567", "one million two hundred thirty-four thousand five hundred sixty-seven",
+			"un million deux cent trente-quatre mille cinq cent soixante-sept",
+			"eine Million zweihundertvierunddreißigtausendfünfhundertsiebenundsechzig",
+			"un millón doscientos treinta y cuatro mil quinientos sesenta y siete"},
+		{"1000000000000", "one trillion", "un billion", "eine Billion", "un billón"},
+		{"9223372036854775808", "nine quintillion two hundred twenty-three quadrillion three hundred seventy-two trillion thirty-six billion eight hundred fifty-four million seven hundred seventy-five thousand eight hundred eight",
+			"neuf trillions deux cent vingt-trois billiards trois cent soixante-douze billions trente-six milliards huit cent cinquante-quatre millions sept cent soixante-quinze mille huit cent huit",
+			"neun Trillionen zweihundertdreiundzwanzig Billiarden dreihundertzweiundsiebzig Billionen sechsunddreißig Milliarden achthundertvierundfünfzig Millionen siebenhundertfünfundsiebzigtausendachthundertacht",
+			"nueve trillones doscientos veintitrés mil trescientos setenta y dos billones treinta y seis mil ochocientos cincuenta y cuatro millones setecientos setenta y cinco mil ochocientos ocho"},
+		{"1000000000000000000000000000000000000", "one thousand decillion", "mille quintilliards", "eintausend Quintilliarden", "un millón quintillones"},
+	} {
+		n, _ := new(big.Int).SetString(tc.n, 10)
+		for lang, want := range map[Language]string{English: tc.english, French: tc.french, German: tc.german, Spanish: tc.spanish} {
+			if got := lang.Cardinal(n); got != want {
+				t.Errorf("%T %s: wanted %q, got %q", lang, tc.n, want, got)
+			}
+		}
+	}
+}
+
+func TestSpeller_Spell(t *testing.T) {
+	for _, tc := range []struct {
+		speller Speller
+		m       Money
+		want    string
+	}{
+		{Speller{}, NewMoney(NewCents(100), MustCurrency("USD")), "one dollar"},
+		{Speller{}, NewMoney(NewCents(1), MustCurrency("USD")), "one cent"},
+		{Speller{}, NewMoney(NewCents(-150), MustCurrency("USD")), "minus one dollar and fifty cents"},
+		{Speller{}, NewMoney(NewInt(0), MustCurrency("USD")), "zero dollars"},
+		{Speller{MinorDigits: true}, NewMoney(NewCents(5), MustCurrency("USD")), "zero dollars and 05 cents"},
+		{Speller{MinorDigits: true}, NewMoney(New(1), MustCurrency("KWD")), "one dinar and 000 fils"},
+		{Speller{}, NewMoney(NewScalar(12345, 3), MustCurrency("USD")), "twelve dollars and thirty-four cents"},
+		{Speller{Rounding: ToNearestAway}, NewMoney(NewScalar(12345, 3), MustCurrency("USD")), "twelve dollars and thirty-five cents"},
+		{Speller{}, NewMoney(NewScalar(-4, 3), MustCurrency("USD")), "zero dollars"},
+		{Speller{}, NewMoney(NewInt(1234), MustCurrency("JPY")), "one thousand two hundred thirty-four yen"},
+		{Speller{}, NewMoney(NewInt(5), MustCurrency("SEK")), "five SEK"},
+		{Speller{Language: French}, NewMoney(NewCents(2100), MustCurrency("GBP")), "vingt et une livres"},
+		{Speller{Language: French}, NewMoney(NewCents(100), MustCurrency("GBP")), "une livre"},
+		{Speller{Language: French}, NewMoney(New(1000000), MustCurrency("EUR")), "un million d'euros"},
+		{Speller{Language: French}, NewMoney(New(2000000), MustCurrency("GBP")), "deux millions de livres"},
+		{Speller{Language: French}, NewMoney(New(1200000), MustCurrency("EUR")), "un million deux cent mille euros"},
+		{Speller{Language: French}, NewMoney(NewCents(-8050), MustCurrency("EUR")), "moins quatre-vingts euros et cinquante centimes"},
+		{Speller{Language: German}, NewMoney(NewCents(100), MustCurrency("EUR")), "ein Euro"},
+		{Speller{Language: German}, NewMoney(NewCents(100), MustCurrency("SEK")), "eine Krone"},
+		{Speller{Language: German}, NewMoney(NewCents(10101), MustCurrency("EUR")), "einhundertein Euro und ein Cent"},
+		{Speller{Language: German}, NewMoney(New(1000000), MustCurrency("CHF")), "eine Million Franken"},
+		{Speller{Language: Spanish}, NewMoney(NewCents(2100), MustCurrency("USD")), "veintiún dólares"},
+		{Speller{Language: Spanish}, NewMoney(NewCents(2100), MustCurrency("GBP")), "veintiuna libras"},
+		{Speller{Language: Spanish}, NewMoney(New(200000), MustCurrency("GBP")), "doscientas mil libras"},
+		{Speller{Language: Spanish}, NewMoney(New(1000000), MustCurrency("EUR")), "un millón de euros"},
+		{Speller{Language: Spanish}, NewMoney(NewCents(100), MustCurrency("EUR")), "un euro"},
+		{Speller{Language: Spanish, Capitalize: true}, NewMoney(NewCents(-101), MustCurrency("EUR")), "Menos un euro con un céntimo"},
+	} {
+		if got := tc.speller.Spell(tc.m); got != tc.want {
+			t.Errorf("%T %v: wanted %q, got %q", tc.speller.Language, tc.m, tc.want, got)
+		}
+	}
+}
+
+func TestSpeller_SpellLarge(t *testing.T) {
+	for _, tc := range []struct {
+		speller Speller
+		m       Money
+		want    string
+	}{
+		{Speller{}, NewMoney(MustParse("123456789012345678901234567890.12"), MustCurrency("USD")), "one hundred twenty-three octillion four hundred fifty-six septillion seven hundred eighty-nine sextillion twelve quintillion three hundred forty-five quadrillion six hundred seventy-eight trillion nine hundred one billion two hundred thirty-four million five hundred sixty-seven thousand eight hundred ninety dollars and twelve cents"},
+		{Speller{}, NewMoney(MustParse("1e40"), MustCurrency("EUR")), "ten million decillion euros"},
+		{Speller{}, NewMoney(MustParse("-1e40"), MustCurrency("JPY")), "minus ten million decillion yen"},
+		{Speller{}, NewMoney(MustParse("10000000000000000000000000000000000000000.005"), MustCurrency("EUR")), "ten million decillion euros"},
+		{Speller{Rounding: ToPositiveInf}, NewMoney(MustParse("10000000000000000000000000000000000000000.001"), MustCurrency("EUR")), "ten million decillion euros and one cent"},
+		{Speller{Language: German}, NewMoney(MustParse("1e40"), MustCurrency("EUR")), "zehn Millionen Quintilliarden Euro"},
+		{Speller{}, NewMoney(MustParse("1e33"), MustCurrency("EUR")), "one decillion euros"},
+		{Speller{}, NewMoney(MustParse("2e33"), MustCurrency("EUR")), "two decillion euros"},
+		{Speller{Language: French}, NewMoney(MustParse("1e33"), MustCurrency("EUR")), "un quintilliard d'euros"},
+		{Speller{Language: French}, NewMoney(MustParse("2e33"), MustCurrency("EUR")), "deux quintilliards d'euros"},
+		{Speller{Language: German}, NewMoney(MustParse("1e33"), MustCurrency("EUR")), "eine Quintilliarde Euro"},
+		{Speller{Language: German}, NewMoney(MustParse("2e33"), MustCurrency("EUR")), "zwei Quintilliarden Euro"},
+		{Speller{Language: Spanish}, NewMoney(MustParse("1e30"), MustCurrency("EUR")), "un quintillón de euros"},
+		{Speller{Language: Spanish}, NewMoney(MustParse("2e30"), MustCurrency("EUR")), "dos quintillones de euros"},
+	} {
+		if got := tc.speller.Spell(tc.m); got != tc.want {
+			t.Errorf("%v: wanted %q, got %q", tc.m, tc.want, got)
+		}
+	}
+}